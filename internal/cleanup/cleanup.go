@@ -0,0 +1,1106 @@
+// Package cleanup removes the evaluations of retired flags from source code.
+package cleanup
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// Result is the outcome of rewriting a file.
+type Result struct {
+	// Source is the rewritten file, or nil if it is unchanged
+	Source []byte
+	// Rewritten is the number of evaluations of the flag that were replaced by its value
+	Rewritten int
+	// Remaining are the lines of references to the accessor that couldn't be rewritten,
+	// e.g. calls of ValueWithDetails, which have to be removed by hand
+	Remaining []int
+}
+
+// Accessor names the generated Go accessors of a flag.
+type Accessor struct {
+	// Name is the package-level accessor, e.g. EnableFeatureA or Checkout.NewFlow
+	Name string
+	// Method is the accessor method of the generated Client, e.g. EnableFeatureA or CheckoutNewFlow
+	Method string
+}
+
+// RewriteGo replaces the evaluations of the generated Go accessors in the file, e.g.
+// EnableFeatureA.Value(ctx, evalCtx) or client.EnableFeatureA(ctx, evalCtx), by the value of the flag and a nil error,
+// and simplifies the result:
+// variables holding them are inlined where they are never reassigned, constant conditions are folded,
+// and if statements with a constant condition are replaced by the branch that is taken.
+// Imports and local variables that are no longer used after the rewrite are removed, so that the file still compiles.
+//
+// The package-level accessor may be qualified by the package it is imported from. The methods are matched
+// on receivers that look like a Client: variables of the type Client, variables and fields whose name
+// contains client, and calls of NewClient and NewDomainClient.
+func RewriteGo(filename string, src []byte, accessor Accessor, flagType flagset.FlagType, value any) (*Result, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %q: %w", filename, err)
+	}
+
+	r := &rewriter{
+		accessor:   accessor,
+		flagType:   flagType,
+		value:      value,
+		substitute: map[*ast.Object][2]ast.Expr{},
+		mutated:    mutatedObjects(f),
+		results:    resultTypes(f),
+	}
+	result := &Result{}
+	if r.literal() != nil {
+		before := countReferences(f)
+		walk(f, nil, r.rewriteStmts)
+		if r.rewritten > 0 {
+			r.typed = r.typedUses(f)
+			walk(f, r.substituteExpr, nil)
+			for r.changed = true; r.changed; {
+				r.changed = false
+				walk(f, r.simplifyExpr, r.simplifyStmts)
+			}
+			r.removeUnusedVars(f, before)
+			r.removeImports(f, before)
+			r.removeComments(f)
+			r.removeLines(fset.File(f.Pos()), src)
+		}
+	}
+
+	result.Rewritten = r.rewritten
+	if r.rewritten > 0 {
+		var buf bytes.Buffer
+		if err := format.Node(&buf, fset, f); err != nil {
+			return nil, fmt.Errorf("error formatting %q: %w", filename, err)
+		}
+		result.Source = buf.Bytes()
+
+		// Report the lines of the remaining references in the rewritten file
+		fset = token.NewFileSet()
+		if f, err = parser.ParseFile(fset, filename, result.Source, 0); err != nil {
+			return nil, fmt.Errorf("error parsing the rewritten %q: %w", filename, err)
+		}
+	}
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		if expr, ok := n.(ast.Expr); ok && r.isReference(expr) {
+			result.Remaining = append(result.Remaining, fset.Position(expr.Pos()).Line)
+			return false
+		}
+		return true
+	})
+	return result, nil
+}
+
+type rewriter struct {
+	accessor Accessor
+	flagType flagset.FlagType
+	value    any
+	// substitute are the untyped and the typed expressions that replace the variables holding the results of evaluations
+	substitute map[*ast.Object][2]ast.Expr
+	// typed are the uses of the substituted variables that are replaced by the typed expression
+	typed map[*ast.Ident]bool
+	// results are the result types of the functions the return statements return from
+	results map[*ast.ReturnStmt]*ast.FieldList
+	// mutated are the variables that are assigned after their declaration or whose address is taken
+	mutated map[*ast.Object]bool
+	// removed are the ranges of the removed code, whose comments are removed as well
+	removed   [][2]token.Pos
+	rewritten int
+	changed   bool
+}
+
+// match reports whether the expression is the package-level accessor, optionally qualified by the package
+// it is imported from
+func (r *rewriter) match(expr ast.Expr) bool {
+	name := types.ExprString(expr)
+	qualifier, ok := strings.CutSuffix(name, "."+r.accessor.Name)
+	return name == r.accessor.Name || ok && token.IsIdentifier(qualifier)
+}
+
+// isReference reports whether the expression is the package-level accessor or an accessor method of a Client,
+// e.g. client.EnableFeatureAWithDetails
+func (r *rewriter) isReference(expr ast.Expr) bool {
+	if r.match(expr) {
+		return true
+	}
+	selector, ok := expr.(*ast.SelectorExpr)
+	return ok && r.accessor.Method != "" && isClient(selector.X) &&
+		(selector.Sel.Name == r.accessor.Method || selector.Sel.Name == r.accessor.Method+"WithDetails")
+}
+
+// isEvaluation reports whether the expression calls the Value function of the package-level accessor
+// or the accessor method of a Client
+func (r *rewriter) isEvaluation(expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	if selector.Sel.Name == "Value" && r.match(selector.X) {
+		return true
+	}
+	return r.accessor.Method != "" && selector.Sel.Name == r.accessor.Method && len(call.Args) == 2 && isClient(selector.X)
+}
+
+// isClient reports whether the expression looks like a generated Client: a variable declared as a Client,
+// a variable or a field whose name contains client, e.g. flagClient or s.client, or a call of NewClient or NewDomainClient
+func isClient(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return strings.Contains(strings.ToLower(e.Name), "client") || declaredClient(e)
+	case *ast.SelectorExpr:
+		return strings.Contains(strings.ToLower(e.Sel.Name), "client")
+	case *ast.ParenExpr:
+		return isClient(e.X)
+	case *ast.CallExpr:
+		return isClientConstructor(e.Fun)
+	}
+	return false
+}
+
+// declaredClient reports whether the variable is declared with the type Client or *Client, optionally qualified,
+// or assigned a call of NewClient or NewDomainClient by its declaration
+func declaredClient(ident *ast.Ident) bool {
+	if ident.Obj == nil {
+		return false
+	}
+	isClientType := func(expr ast.Expr) bool {
+		if star, ok := expr.(*ast.StarExpr); ok {
+			expr = star.X
+		}
+		name := types.ExprString(expr)
+		return name == "Client" || strings.HasSuffix(name, ".Client")
+	}
+	switch decl := ident.Obj.Decl.(type) {
+	case *ast.Field:
+		return isClientType(decl.Type)
+	case *ast.ValueSpec:
+		if decl.Type != nil {
+			return isClientType(decl.Type)
+		}
+		i := slices.IndexFunc(decl.Names, func(name *ast.Ident) bool { return name.Obj == ident.Obj })
+		return len(decl.Values) == len(decl.Names) && i >= 0 && isClient(decl.Values[i])
+	case *ast.AssignStmt:
+		i := slices.IndexFunc(decl.Lhs, func(expr ast.Expr) bool {
+			lhs, ok := expr.(*ast.Ident)
+			return ok && lhs.Obj == ident.Obj
+		})
+		return len(decl.Lhs) == len(decl.Rhs) && i >= 0 && isClient(decl.Rhs[i])
+	}
+	return false
+}
+
+// isClientConstructor reports whether the function is NewClient or NewDomainClient, optionally qualified
+func isClientConstructor(fun ast.Expr) bool {
+	name := types.ExprString(fun)
+	name = name[strings.LastIndex(name, ".")+1:]
+	return name == "NewClient" || name == "NewDomainClient"
+}
+
+// literal returns the value of the flag as an untyped constant expression, or nil for unsupported types
+func (r *rewriter) literal() ast.Expr {
+	switch r.flagType {
+	case flagset.BoolType:
+		if b, ok := r.value.(bool); ok {
+			return ast.NewIdent(strconv.FormatBool(b))
+		}
+	case flagset.StringType:
+		if s, ok := r.value.(string); ok {
+			return &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(s)}
+		}
+	case flagset.IntType:
+		if n, ok := toFloat(r.value); ok && n == math.Trunc(n) {
+			return signed(n < 0, &ast.BasicLit{Kind: token.INT, Value: strconv.FormatInt(int64(math.Abs(n)), 10)})
+		}
+	case flagset.FloatType:
+		if n, ok := toFloat(r.value); ok {
+			s := strconv.FormatFloat(math.Abs(n), 'g', -1, 64)
+			if !strings.ContainsAny(s, ".e") {
+				s += ".0"
+			}
+			return signed(n < 0, &ast.BasicLit{Kind: token.FLOAT, Value: s})
+		}
+	}
+	return nil
+}
+
+// typedLiteral returns the value of the flag as a constant expression of the type the accessor returns
+func (r *rewriter) typedLiteral() ast.Expr {
+	switch r.flagType {
+	case flagset.IntType:
+		return &ast.CallExpr{Fun: ast.NewIdent("int64"), Args: []ast.Expr{r.literal()}}
+	case flagset.FloatType:
+		return &ast.CallExpr{Fun: ast.NewIdent("float64"), Args: []ast.Expr{r.literal()}}
+	}
+	return r.literal()
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+func signed(negative bool, lit *ast.BasicLit) ast.Expr {
+	if negative {
+		return &ast.UnaryExpr{Op: token.SUB, X: lit}
+	}
+	return lit
+}
+
+// rewriteStmts replaces the evaluations in the statements
+func (r *rewriter) rewriteStmts(list []ast.Stmt) []ast.Stmt {
+	result := make([]ast.Stmt, 0, len(list))
+	for _, stmt := range list {
+		switch s := stmt.(type) {
+		case *ast.ExprStmt:
+			// The result of the evaluation is discarded
+			if r.isEvaluation(s.X) {
+				r.rewritten++
+				r.remove(s)
+				continue
+			}
+		case *ast.AssignStmt:
+			if r.isAssignment(s) {
+				if stmt = r.rewriteAssignment(s); stmt == nil {
+					continue
+				}
+			}
+		case *ast.ReturnStmt:
+			if len(s.Results) == 1 && r.isEvaluation(s.Results[0]) {
+				r.rewritten++
+				value := r.literal()
+				if r.returnsInterface(s, 0) {
+					value = r.typedLiteral()
+				}
+				s.Results = []ast.Expr{value, ast.NewIdent("nil")}
+			}
+		case *ast.IfStmt:
+			if init, ok := s.Init.(*ast.AssignStmt); ok && r.isAssignment(init) {
+				s.Init = r.rewriteAssignment(init)
+			}
+		case *ast.SwitchStmt:
+			if init, ok := s.Init.(*ast.AssignStmt); ok && r.isAssignment(init) {
+				s.Init = r.rewriteAssignment(init)
+			}
+		}
+		result = append(result, stmt)
+	}
+	return result
+}
+
+// isAssignment reports whether the statement assigns the value and the error of an evaluation
+func (r *rewriter) isAssignment(s *ast.AssignStmt) bool {
+	return len(s.Lhs) == 2 && len(s.Rhs) == 1 && r.isEvaluation(s.Rhs[0])
+}
+
+// rewriteAssignment replaces the evaluation assigned by the statement by the value of the flag and a nil error.
+// Variables that are declared by the statement and never reassigned are substituted by their values,
+// the others are assigned them. The statement is changed in place, so that it still declares its variables.
+// It returns nil if no variable is left to assign.
+func (r *rewriter) rewriteAssignment(s *ast.AssignStmt) ast.Stmt {
+	r.rewritten++
+	values := []ast.Expr{r.literal(), ast.NewIdent("nil")}
+	typedValues := []ast.Expr{r.typedLiteral(), &ast.CallExpr{Fun: ast.NewIdent("error"), Args: []ast.Expr{ast.NewIdent("nil")}}}
+
+	// The statement declares the variables it keeps, unless they are all declared before
+	declares := false
+	for _, expr := range s.Lhs {
+		if ident, ok := expr.(*ast.Ident); ok && s.Tok == token.DEFINE && declaredBy(ident, s) && r.mutated[ident.Obj] {
+			declares = true
+		}
+	}
+
+	var lhs, rhs []ast.Expr
+	for i, expr := range s.Lhs {
+		ident, ok := expr.(*ast.Ident)
+		if ok && ident.Name == "_" {
+			continue
+		}
+		if ok && s.Tok == token.DEFINE && declaredBy(ident, s) && !r.mutated[ident.Obj] {
+			r.substitute[ident.Obj] = [2]ast.Expr{values[i], typedValues[i]}
+			continue
+		}
+		lhs = append(lhs, expr)
+		if declares {
+			rhs = append(rhs, typedValues[i])
+		} else {
+			rhs = append(rhs, values[i])
+		}
+	}
+
+	if len(lhs) == 0 {
+		r.remove(s)
+		return nil
+	}
+	if !declares {
+		s.Tok = token.ASSIGN
+	}
+	s.Lhs, s.Rhs = lhs, rhs
+	return s
+}
+
+// declaredBy reports whether the identifier is declared by the statement
+func declaredBy(ident *ast.Ident, s ast.Stmt) bool {
+	return ident.Obj != nil && ident.Obj.Decl == s
+}
+
+// substituteExpr replaces the variables holding the results of evaluations by their values
+func (r *rewriter) substituteExpr(expr ast.Expr) ast.Expr {
+	if ident, ok := expr.(*ast.Ident); ok && ident.Obj != nil {
+		if values, ok := r.substitute[ident.Obj]; ok {
+			if r.typed[ident] {
+				return clone(values[1])
+			}
+			return clone(values[0])
+		}
+	}
+	return expr
+}
+
+// typedUses returns the uses of the substituted variables whose values have to be typed: the values of declarations
+// without a type, where an untyped constant would get its default type, e.g. int instead of int64,
+// and values in other contexts that may be interfaces, e.g. the arguments of fmt.Println.
+// Compared, assigned and returned values are untyped, unless the result type is an interface.
+// A nil error is only typed in declarations.
+func (r *rewriter) typedUses(f *ast.File) map[*ast.Ident]bool {
+	typed := map[*ast.Ident]bool{}
+	inspect(f, func(n ast.Node, ancestors []ast.Node) {
+		ident, ok := n.(*ast.Ident)
+		if !ok || ident.Obj == nil {
+			return
+		}
+		values, ok := r.substitute[ident.Obj]
+		if !ok {
+			return
+		}
+
+		// The context of parenthesized values is the context of the parentheses
+		var child ast.Node = ident
+		i := len(ancestors) - 1
+		for ; i > 0; i-- {
+			if _, ok := ancestors[i].(*ast.ParenExpr); !ok {
+				break
+			}
+			child = ancestors[i]
+		}
+
+		switch parent := ancestors[i].(type) {
+		case *ast.AssignStmt:
+			typed[ident] = parent.Tok == token.DEFINE
+		case *ast.ValueSpec:
+			typed[ident] = parent.Type == nil
+		case *ast.BinaryExpr:
+			typed[ident] = !isNil(values[0]) && !isComparison(parent.Op)
+		case *ast.ReturnStmt:
+			typed[ident] = !isNil(values[0]) && r.returnsInterface(parent, slices.Index(parent.Results, child.(ast.Expr)))
+		case *ast.IfStmt, *ast.ForStmt:
+			// Conditions are booleans, whose typed and untyped values are the same
+		case *ast.UnaryExpr:
+			typed[ident] = parent.Op != token.NOT
+		default:
+			typed[ident] = !isNil(values[0])
+		}
+	})
+	return typed
+}
+
+func isNil(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == "nil"
+}
+
+func isComparison(op token.Token) bool {
+	switch op {
+	case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+		return true
+	}
+	return false
+}
+
+// returnsInterface reports whether the result of the return statement at the index has an interface type
+func (r *rewriter) returnsInterface(s *ast.ReturnStmt, index int) bool {
+	fields := r.results[s]
+	if fields == nil {
+		return false
+	}
+	for _, field := range fields.List {
+		if index -= max(len(field.Names), 1); index < 0 {
+			ident, ok := field.Type.(*ast.Ident)
+			_, isInterface := field.Type.(*ast.InterfaceType)
+			return isInterface || ok && ident.Name == "any"
+		}
+	}
+	return false
+}
+
+// resultTypes returns the result types of the functions the return statements of the file return from
+func resultTypes(f *ast.File) map[*ast.ReturnStmt]*ast.FieldList {
+	results := map[*ast.ReturnStmt]*ast.FieldList{}
+	inspect(f, func(n ast.Node, ancestors []ast.Node) {
+		s, ok := n.(*ast.ReturnStmt)
+		if !ok {
+			return
+		}
+		for i := len(ancestors) - 1; i >= 0; i-- {
+			switch fn := ancestors[i].(type) {
+			case *ast.FuncDecl:
+				results[s] = fn.Type.Results
+				return
+			case *ast.FuncLit:
+				results[s] = fn.Type.Results
+				return
+			}
+		}
+	})
+	return results
+}
+
+// inspect traverses the syntax tree in depth-first order, passing every node and its ancestors to the function
+func inspect(node ast.Node, fn func(n ast.Node, ancestors []ast.Node)) {
+	var ancestors []ast.Node
+	ast.Inspect(node, func(n ast.Node) bool {
+		if n == nil {
+			ancestors = ancestors[:len(ancestors)-1]
+			return true
+		}
+		fn(n, ancestors)
+		ancestors = append(ancestors, n)
+		return true
+	})
+}
+
+// simplifyExpr folds constant conditions, e.g. !true, true && x, nil != nil or "a" == "b"
+func (r *rewriter) simplifyExpr(expr ast.Expr) ast.Expr {
+	result := simplify(expr)
+	if result != expr {
+		r.changed = true
+	}
+	return result
+}
+
+func simplify(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		if _, ok := constantValue(e.X); ok {
+			return e.X
+		}
+	case *ast.UnaryExpr:
+		if e.Op == token.NOT {
+			if b, ok := boolValue(e.X); ok {
+				return ast.NewIdent(strconv.FormatBool(!b))
+			}
+		}
+	case *ast.BinaryExpr:
+		switch e.Op {
+		case token.LAND, token.LOR:
+			if b, ok := boolValue(e.X); ok {
+				// true && y is y, false && y is false, true || y is true and false || y is y
+				if b == (e.Op == token.LAND) {
+					return e.Y
+				}
+				return ast.NewIdent(strconv.FormatBool(b))
+			}
+			if b, ok := boolValue(e.Y); ok && b == (e.Op == token.LAND) {
+				// x && true and x || false are x, x && false and x || true keep x for its side effects
+				return e.X
+			}
+		case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+			x, xok := constantValue(e.X)
+			y, yok := constantValue(e.Y)
+			if !xok || !yok {
+				break
+			}
+			if x == nil || y == nil {
+				// Only nil == nil and nil != nil are folded, nil is compared to constants in no valid program
+				if x == nil && y == nil && (e.Op == token.EQL || e.Op == token.NEQ) {
+					return ast.NewIdent(strconv.FormatBool(e.Op == token.EQL))
+				}
+				break
+			}
+			if comparable(x, y) {
+				return ast.NewIdent(strconv.FormatBool(constant.Compare(x, e.Op, y)))
+			}
+		}
+	}
+	return expr
+}
+
+func comparable(x, y constant.Value) bool {
+	numeric := func(k constant.Kind) bool { return k == constant.Int || k == constant.Float }
+	return x.Kind() == y.Kind() || numeric(x.Kind()) && numeric(y.Kind())
+}
+
+// constantValue returns the value of a constant expression: true, false, nil (as a nil value) or a literal
+func constantValue(expr ast.Expr) (constant.Value, bool) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if e.Obj != nil {
+			// A local declaration shadows the predeclared identifier
+			return nil, false
+		}
+		switch e.Name {
+		case "true", "false":
+			return constant.MakeBool(e.Name == "true"), true
+		case "nil":
+			return nil, true
+		}
+	case *ast.BasicLit:
+		value := constant.MakeFromLiteral(e.Value, e.Kind, 0)
+		return value, value.Kind() != constant.Unknown
+	case *ast.UnaryExpr:
+		if e.Op != token.SUB {
+			break
+		}
+		if lit, ok := e.X.(*ast.BasicLit); ok {
+			value := constant.MakeFromLiteral(lit.Value, lit.Kind, 0)
+			if value.Kind() == constant.Int || value.Kind() == constant.Float {
+				return constant.UnaryOp(token.SUB, value, 0), true
+			}
+		}
+	}
+	return nil, false
+}
+
+func boolValue(expr ast.Expr) (bool, bool) {
+	value, ok := constantValue(expr)
+	if !ok || value == nil || value.Kind() != constant.Bool {
+		return false, false
+	}
+	return constant.BoolVal(value), true
+}
+
+// simplifyStmts replaces if statements with a constant condition by the branch that is taken
+func (r *rewriter) simplifyStmts(list []ast.Stmt) []ast.Stmt {
+	result := make([]ast.Stmt, 0, len(list))
+	for _, stmt := range list {
+		s, ok := stmt.(*ast.IfStmt)
+		if !ok {
+			result = append(result, stmt)
+			continue
+		}
+		taken, ok := boolValue(s.Cond)
+		if !ok {
+			result = append(result, stmt)
+			continue
+		}
+
+		r.changed = true
+		branch := s.Else
+		if taken {
+			branch = s.Body
+		}
+
+		// Everything but the statements of the branch is removed
+		var body []ast.Stmt
+		from, to := s.End(), s.End()
+		switch b := branch.(type) {
+		case *ast.BlockStmt:
+			body = b.List
+			from, to = b.Lbrace+1, b.Rbrace
+		case *ast.IfStmt:
+			body = []ast.Stmt{b}
+			from, to = b.Pos(), b.End()
+		}
+		r.removeSpan(to, s.End())
+		if s.Init != nil {
+			// The init statement is scoped to the if statement
+			r.removeSpan(s.Pos(), s.Init.Pos())
+			r.removeSpan(s.Init.End(), from)
+			result = append(result, &ast.BlockStmt{Lbrace: s.Pos(), List: append([]ast.Stmt{s.Init}, body...), Rbrace: s.End()})
+			continue
+		}
+		r.removeSpan(s.Pos(), from)
+		if declares(body) {
+			// Keep the scope of the branch, so that its declarations don't clash with the enclosing block
+			result = append(result, &ast.BlockStmt{Lbrace: s.Pos(), List: body, Rbrace: s.End()})
+			continue
+		}
+		result = append(result, body...)
+	}
+	return result
+}
+
+// declares reports whether the statements declare names in their block
+func declares(list []ast.Stmt) bool {
+	for _, stmt := range list {
+		switch s := stmt.(type) {
+		case *ast.AssignStmt:
+			if s.Tok == token.DEFINE {
+				return true
+			}
+		case *ast.DeclStmt, *ast.LabeledStmt:
+			return true
+		}
+	}
+	return false
+}
+
+func (r *rewriter) remove(node ast.Node) {
+	r.removeSpan(node.Pos(), node.End())
+}
+
+func (r *rewriter) removeSpan(from, to token.Pos) {
+	if from < to {
+		r.removed = append(r.removed, [2]token.Pos{from, to})
+	}
+}
+
+// removeComments removes the comments of the removed code
+func (r *rewriter) removeComments(f *ast.File) {
+	comments := f.Comments[:0]
+	for _, group := range f.Comments {
+		removed := false
+		for _, span := range r.removed {
+			if group.Pos() >= span[0] && group.End() <= span[1] {
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			comments = append(comments, group)
+		}
+	}
+	f.Comments = comments
+}
+
+// removeLines removes the lines of the removed code from the file, so that it leaves no blank lines behind
+func (r *rewriter) removeLines(file *token.File, src []byte) {
+	for line := file.LineCount() - 1; line > 0; line-- {
+		start, end := file.Offset(file.LineStart(line)), file.Offset(file.LineStart(line+1))
+		if r.removedLine(file, src[start:end], start) {
+			// Merging the line with the next one removes it from the positions of the code that follows
+			file.MergeLine(line)
+		}
+	}
+}
+
+// removedLine reports whether the line starting at the offset has code and all of it was removed
+func (r *rewriter) removedLine(file *token.File, line []byte, offset int) bool {
+	empty := true
+	for i, c := range line {
+		if c == ' ' || c == '\t' || c == '\r' || c == '\n' {
+			continue
+		}
+		empty = false
+		pos := file.Pos(offset + i)
+		if !slices.ContainsFunc(r.removed, func(span [2]token.Pos) bool { return span[0] <= pos && pos < span[1] }) {
+			return false
+		}
+	}
+	return !empty
+}
+
+// references are the uses of the local variables and of the imported packages in a file
+type references struct {
+	vars     map[*ast.Object]int
+	packages map[string]int
+}
+
+// countReferences counts the uses of the variables and the packages in the file.
+// Assigning a variable doesn't use it, as for the compiler.
+func countReferences(f *ast.File) references {
+	assigned := map[*ast.Ident]bool{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch s := n.(type) {
+		case *ast.AssignStmt:
+			for _, expr := range s.Lhs {
+				if ident, ok := expr.(*ast.Ident); ok {
+					assigned[ident] = true
+				}
+			}
+		case *ast.IncDecStmt:
+			if ident, ok := s.X.(*ast.Ident); ok {
+				assigned[ident] = true
+			}
+		case *ast.RangeStmt:
+			for _, expr := range []ast.Expr{s.Key, s.Value} {
+				if ident, ok := expr.(*ast.Ident); ok {
+					assigned[ident] = true
+				}
+			}
+		case *ast.ValueSpec:
+			for _, ident := range s.Names {
+				assigned[ident] = true
+			}
+		}
+		return true
+	})
+
+	refs := references{vars: map[*ast.Object]int{}, packages: map[string]int{}}
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch e := n.(type) {
+		case *ast.SelectorExpr:
+			if ident, ok := e.X.(*ast.Ident); ok && ident.Obj == nil {
+				refs.packages[ident.Name]++
+			}
+		case *ast.Ident:
+			if e.Obj != nil && e.Obj.Kind == ast.Var && !assigned[e] {
+				refs.vars[e.Obj]++
+			}
+		}
+		return true
+	})
+	return refs
+}
+
+// removeUnusedVars removes the local variables that were used before the rewrite and no longer are,
+// e.g. variables only used by a removed branch, along with their assignments.
+// The values assigned to them are kept, assigned to the blank identifier, if they may have side effects.
+func (r *rewriter) removeUnusedVars(f *ast.File, before references) {
+	for {
+		after := countReferences(f)
+		unused := map[*ast.Object]bool{}
+		for obj, uses := range before.vars {
+			if uses > 0 && after.vars[obj] == 0 {
+				unused[obj] = true
+			}
+		}
+		r.changed = false
+		walk(f, nil, func(list []ast.Stmt) []ast.Stmt {
+			return r.removeAssignments(list, unused)
+		})
+		if !r.changed {
+			return
+		}
+	}
+}
+
+// removeAssignments removes the declarations and the assignments of the unused variables from the statements
+func (r *rewriter) removeAssignments(list []ast.Stmt, unused map[*ast.Object]bool) []ast.Stmt {
+	isUnused := func(expr ast.Expr) bool {
+		ident, ok := expr.(*ast.Ident)
+		return ok && ident.Obj != nil && unused[ident.Obj]
+	}
+
+	result := make([]ast.Stmt, 0, len(list))
+	for _, stmt := range list {
+		switch s := stmt.(type) {
+		case *ast.AssignStmt:
+			if stmt = r.removeTargets(s, isUnused); stmt == nil {
+				continue
+			}
+		case *ast.IncDecStmt:
+			if isUnused(s.X) {
+				r.changed = true
+				r.remove(s)
+				continue
+			}
+		case *ast.DeclStmt:
+			if stmt = r.removeSpecs(s, isUnused); stmt == nil {
+				continue
+			}
+		case *ast.RangeStmt:
+			if !isUnused(s.Key) && !isUnused(s.Value) {
+				break
+			}
+			r.changed = true
+			if isUnused(s.Value) {
+				s.Value = nil
+			}
+			if isUnused(s.Key) {
+				s.Key = ast.NewIdent("_")
+			}
+			if isBlank(s.Key) && s.Value == nil {
+				s.Key, s.Tok = nil, token.ILLEGAL
+			}
+		}
+		result = append(result, stmt)
+	}
+	return result
+}
+
+// removeTargets removes the unused variables from the assignment, returning nil if nothing is left to assign
+func (r *rewriter) removeTargets(s *ast.AssignStmt, isUnused func(ast.Expr) bool) ast.Stmt {
+	if !slices.ContainsFunc(s.Lhs, isUnused) {
+		return s
+	}
+	r.changed = true
+	from, to := s.Pos(), s.End()
+	if s.Tok != token.DEFINE && s.Tok != token.ASSIGN {
+		// An operation assignment, e.g. x += y
+		if pure(s.Rhs[0]) {
+			r.remove(s)
+			return nil
+		}
+		return &ast.AssignStmt{Lhs: []ast.Expr{ast.NewIdent("_")}, TokPos: s.TokPos, Tok: token.ASSIGN, Rhs: s.Rhs}
+	}
+
+	for i, expr := range s.Lhs {
+		if isUnused(expr) {
+			s.Lhs[i] = ast.NewIdent("_")
+		}
+	}
+	if len(s.Lhs) == len(s.Rhs) {
+		var lhs, rhs []ast.Expr
+		for i := range s.Lhs {
+			if !isBlank(s.Lhs[i]) || !pure(s.Rhs[i]) {
+				lhs = append(lhs, s.Lhs[i])
+				rhs = append(rhs, s.Rhs[i])
+			}
+		}
+		s.Lhs, s.Rhs = lhs, rhs
+	}
+	if len(s.Lhs) == 0 {
+		r.removeSpan(from, to)
+		return nil
+	}
+	if s.Tok == token.DEFINE && !slices.ContainsFunc(s.Lhs, func(expr ast.Expr) bool {
+		ident, ok := expr.(*ast.Ident)
+		return ok && declaredBy(ident, s)
+	}) {
+		s.Tok = token.ASSIGN
+	}
+	return s
+}
+
+// removeSpecs removes the unused variables from the declaration, returning nil if nothing is left to declare
+func (r *rewriter) removeSpecs(s *ast.DeclStmt, isUnused func(ast.Expr) bool) ast.Stmt {
+	decl, ok := s.Decl.(*ast.GenDecl)
+	if !ok || decl.Tok != token.VAR {
+		return s
+	}
+	from, to := s.Pos(), s.End()
+	specs := decl.Specs[:0]
+	for _, spec := range decl.Specs {
+		spec := spec.(*ast.ValueSpec)
+		var names []*ast.Ident
+		var values []ast.Expr
+		for i, name := range spec.Names {
+			switch {
+			case !isUnused(name):
+			case len(spec.Values) == 0:
+				// var x T
+				r.changed = true
+				continue
+			case len(spec.Values) == len(spec.Names) && pure(spec.Values[i]):
+				r.changed = true
+				continue
+			default:
+				r.changed = true
+				name = ast.NewIdent("_")
+			}
+			names = append(names, name)
+			if len(spec.Values) == len(spec.Names) {
+				values = append(values, spec.Values[i])
+			}
+		}
+		if len(names) == 0 {
+			r.remove(spec)
+			continue
+		}
+		spec.Names = names
+		if len(spec.Values) == len(spec.Names) {
+			spec.Values = values
+		}
+		specs = append(specs, spec)
+	}
+	decl.Specs = specs
+	if len(specs) == 0 {
+		r.removeSpan(from, to)
+		return nil
+	}
+	return s
+}
+
+func isBlank(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == "_"
+}
+
+// pureFuncs are the functions that have no side effects, whose unused results can be dropped.
+// The constructors of the generated Client have none either.
+var pureFuncs = map[string]bool{
+	"context.Background": true, "context.TODO": true, "errors.New": true, "fmt.Errorf": true, "fmt.Sprint": true, "fmt.Sprintf": true, "fmt.Sprintln": true,
+	"len": true, "cap": true, "new": true, "make": true,
+	"bool": true, "string": true, "int": true, "int64": true, "float64": true, "error": true,
+}
+
+// pure reports whether evaluating the expression has no side effects, so that it can be dropped
+func pure(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.Ident, *ast.BasicLit, *ast.FuncLit:
+		return true
+	case *ast.ParenExpr:
+		return pure(e.X)
+	case *ast.SelectorExpr:
+		return pure(e.X)
+	case *ast.StarExpr:
+		return pure(e.X)
+	case *ast.UnaryExpr:
+		return e.Op != token.ARROW && pure(e.X)
+	case *ast.BinaryExpr:
+		return pure(e.X) && pure(e.Y)
+	case *ast.KeyValueExpr:
+		return pure(e.Key) && pure(e.Value)
+	case *ast.CompositeLit:
+		return !slices.ContainsFunc(e.Elts, func(elt ast.Expr) bool { return !pure(elt) })
+	case *ast.CallExpr:
+		return (pureFuncs[types.ExprString(e.Fun)] || isClientConstructor(e.Fun)) && !slices.ContainsFunc(e.Args, func(arg ast.Expr) bool { return !pure(arg) })
+	}
+	return false
+}
+
+// removeImports removes the imports of the packages that were used before the rewrite and no longer are
+func (r *rewriter) removeImports(f *ast.File, before references) {
+	after := countReferences(f)
+	unused := func(spec *ast.ImportSpec) bool {
+		name := importName(spec)
+		return before.packages[name] > 0 && after.packages[name] == 0
+	}
+
+	for i := 0; i < len(f.Decls); i++ {
+		decl, ok := f.Decls[i].(*ast.GenDecl)
+		if !ok || decl.Tok != token.IMPORT {
+			continue
+		}
+		from, to := decl.Pos(), decl.End()
+		specs := decl.Specs[:0]
+		for _, spec := range decl.Specs {
+			if unused(spec.(*ast.ImportSpec)) {
+				r.remove(spec)
+				continue
+			}
+			specs = append(specs, spec)
+		}
+		decl.Specs = specs
+		if len(specs) == 0 {
+			r.removeSpan(from, to)
+			f.Decls = append(f.Decls[:i], f.Decls[i+1:]...)
+			i--
+		}
+	}
+	f.Imports = slices.DeleteFunc(f.Imports, unused)
+}
+
+// importName returns the name the package is imported as, assuming it is the last element of its path
+func importName(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+	path, _ := strconv.Unquote(spec.Path.Value)
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// mutatedObjects returns the variables that are assigned or incremented, or whose address is taken
+func mutatedObjects(f *ast.File) map[*ast.Object]bool {
+	mutated := map[*ast.Object]bool{}
+	mark := func(expr ast.Expr, declaration ast.Node) {
+		if ident, ok := expr.(*ast.Ident); ok && ident.Obj != nil && ident.Obj.Decl != declaration {
+			mutated[ident.Obj] = true
+		}
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch s := n.(type) {
+		case *ast.AssignStmt:
+			for _, expr := range s.Lhs {
+				mark(expr, s)
+			}
+		case *ast.IncDecStmt:
+			mark(s.X, nil)
+		case *ast.UnaryExpr:
+			if s.Op == token.AND {
+				mark(s.X, nil)
+			}
+		case *ast.RangeStmt:
+			if s.Tok == token.ASSIGN {
+				mark(s.Key, nil)
+				mark(s.Value, nil)
+			}
+		}
+		return true
+	})
+	return mutated
+}
+
+// clone returns a copy of a constant expression
+func clone(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return ast.NewIdent(e.Name)
+	case *ast.BasicLit:
+		return &ast.BasicLit{Kind: e.Kind, Value: e.Value}
+	case *ast.UnaryExpr:
+		return &ast.UnaryExpr{Op: e.Op, X: clone(e.X)}
+	case *ast.CallExpr:
+		args := make([]ast.Expr, len(e.Args))
+		for i, arg := range e.Args {
+			args[i] = clone(arg)
+		}
+		return &ast.CallExpr{Fun: clone(e.Fun), Args: args}
+	}
+	return expr
+}
+
+var (
+	exprType     = reflect.TypeOf((*ast.Expr)(nil)).Elem()
+	stmtListType = reflect.TypeOf([]ast.Stmt(nil))
+	nodeType     = reflect.TypeOf((*ast.Node)(nil)).Elem()
+	objectType   = reflect.TypeOf((*ast.Object)(nil))
+	scopeType    = reflect.TypeOf((*ast.Scope)(nil))
+)
+
+// walk traverses the syntax tree bottom-up, replacing every expression by the result of exprFunc
+// and every statement list by the result of stmtsFunc. Either function may be nil.
+func walk(node ast.Node, exprFunc func(ast.Expr) ast.Expr, stmtsFunc func([]ast.Stmt) []ast.Stmt) {
+	walkValue(reflect.ValueOf(node), exprFunc, stmtsFunc)
+}
+
+func walkValue(v reflect.Value, exprFunc func(ast.Expr) ast.Expr, stmtsFunc func([]ast.Stmt) []ast.Stmt) {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Pointer:
+		if v.IsNil() || v.Type() == objectType || v.Type() == scopeType {
+			return
+		}
+		walkValue(v.Elem(), exprFunc, stmtsFunc)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Field(i)
+			if !field.CanSet() {
+				continue
+			}
+			walkValue(field, exprFunc, stmtsFunc)
+			switch {
+			case field.Type() == exprType && exprFunc != nil && !field.IsNil():
+				field.Set(reflect.ValueOf(exprFunc(field.Interface().(ast.Expr))))
+			case field.Type() == stmtListType && stmtsFunc != nil:
+				field.Set(reflect.ValueOf(stmtsFunc(field.Interface().([]ast.Stmt))))
+			}
+		}
+	case reflect.Slice:
+		if !v.Type().Elem().Implements(nodeType) {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			element := v.Index(i)
+			walkValue(element, exprFunc, stmtsFunc)
+			if element.Type() == exprType && exprFunc != nil && !element.IsNil() {
+				element.Set(reflect.ValueOf(exprFunc(element.Interface().(ast.Expr))))
+			}
+		}
+	}
+}
@@ -0,0 +1,430 @@
+package cleanup
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+func TestRewriteGo(t *testing.T) {
+	tests := []struct {
+		name      string
+		accessor  string
+		method    string
+		flagType  flagset.FlagType
+		value     any
+		src       string
+		expected  string
+		remaining []int
+	}{
+		{
+			name:     "inlines the taken branch",
+			accessor: "EnableFeatureA",
+			flagType: flagset.BoolType,
+			value:    true,
+			src: `package main
+
+import (
+	"context"
+	"fmt"
+
+	"example.com/app/flags"
+)
+
+func run(ctx context.Context) error {
+	enabled, err := flags.EnableFeatureA.Value(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if enabled {
+		fmt.Println("new")
+	} else {
+		// the old behavior
+		fmt.Println("old")
+	}
+	return nil
+}
+`,
+			expected: `package main
+
+import (
+	"context"
+	"fmt"
+)
+
+func run(ctx context.Context) error {
+	fmt.Println("new")
+	return nil
+}
+`,
+		},
+		{
+			name:     "removes the branch that is not taken",
+			accessor: "EnableFeatureA",
+			flagType: flagset.BoolType,
+			value:    false,
+			src: `package main
+
+func run() {
+	if on, _ := EnableFeatureA.Value(ctx, nil); on && ready() {
+		start()
+	} else if other() {
+		stop()
+	}
+	if v, err := EnableFeatureA.Value(ctx, nil); err == nil && !v {
+		x := 1
+		use(x)
+	}
+}
+`,
+			expected: `package main
+
+func run() {
+	if other() {
+		stop()
+	}
+	{
+		x := 1
+		use(x)
+	}
+}
+`,
+		},
+		{
+			name:     "compares constants",
+			accessor: "Checkout.Theme",
+			flagType: flagset.StringType,
+			value:    "dark",
+			src: `package main
+
+func run() (string, error) {
+	theme, _ := Checkout.Theme.Value(ctx, nil)
+	if theme == "light" {
+		light()
+	}
+	return Checkout.Theme.Value(ctx, nil)
+}
+`,
+			expected: `package main
+
+func run() (string, error) {
+	return "dark", nil
+}
+`,
+		},
+		{
+			name:     "removes the variables and imports only used by the removed branch",
+			accessor: "NewFlow",
+			flagType: flagset.BoolType,
+			value:    true,
+			src: `package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"example.com/app/flags"
+)
+
+func run(items []int) error {
+	msg := fmt.Sprintf("%d items", len(items))
+	var fallback = errors.New("old flow")
+	total := 0
+	for i, item := range items {
+		total += item
+		log.Print(i)
+	}
+	newFlow, err := flags.NewFlow.Value(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if !newFlow {
+		log.Print(msg, total)
+		return fallback
+	}
+	return nil
+}
+`,
+			expected: `package main
+
+import (
+	"log"
+)
+
+func run(items []int) error {
+	for i := range items {
+		log.Print(i)
+	}
+	return nil
+}
+`,
+		},
+		{
+			name:     "rewrites the methods of clients",
+			accessor: "Checkout.NewFlow",
+			method:   "CheckoutNewFlow",
+			flagType: flagset.BoolType,
+			value:    false,
+			src: `package main
+
+import "example.com/app/flags"
+
+func run(c *flags.Client) {
+	if on, _ := c.CheckoutNewFlow(ctx, nil); on {
+		start()
+	}
+	enabled, err := flags.NewDomainClient("checkout").CheckoutNewFlow(ctx, nil)
+	if err == nil && enabled {
+		stop()
+	}
+	details, _ := c.CheckoutNewFlowWithDetails(ctx, nil)
+	use(details, other.CheckoutNewFlow(ctx, nil))
+}
+`,
+			expected: `package main
+
+import "example.com/app/flags"
+
+func run(c *flags.Client) {
+	details, _ := c.CheckoutNewFlowWithDetails(ctx, nil)
+	use(details, other.CheckoutNewFlow(ctx, nil))
+}
+`,
+			remaining: []int{6},
+		},
+		{
+			name:     "types the values of declarations and interfaces",
+			accessor: "MaxItems",
+			flagType: flagset.IntType,
+			value:    float64(25),
+			src: `package main
+
+func run() (any, error) {
+	limit, err := MaxItems.Value(ctx, nil)
+	capped := limit
+	var total = -limit * 2
+	var max int64 = limit
+	fmt.Println(limit > 10, capped, total, max)
+	failure := err
+	use(failure, err)
+	return limit, err
+}
+
+func maxItems() (any, error) {
+	return MaxItems.Value(ctx, nil)
+}
+`,
+			expected: `package main
+
+func run() (any, error) {
+	capped := int64(25)
+	var total = -int64(25) * 2
+	var max int64 = 25
+	fmt.Println(true, capped, total, max)
+	failure := error(nil)
+	use(failure, nil)
+	return int64(25), nil
+}
+
+func maxItems() (any, error) {
+	return int64(25), nil
+}
+`,
+		},
+		{
+			name:     "assigns reassigned variables",
+			accessor: "MaxItems",
+			flagType: flagset.IntType,
+			value:    float64(-3),
+			src: `package main
+
+func run() {
+	limit, err := MaxItems.Value(ctx, nil)
+	limit++
+	var other int64
+	other, err = MaxItems.Value(ctx, nil)
+	use(limit, other, err)
+	details, _ := MaxItems.ValueWithDetails(ctx, nil)
+	use(details)
+}
+`,
+			expected: `package main
+
+func run() {
+	limit, err := int64(-3), error(nil)
+	limit++
+	var other int64
+	other, err = -3, nil
+	use(limit, other, err)
+	details, _ := MaxItems.ValueWithDetails(ctx, nil)
+	use(details)
+}
+`,
+			remaining: []int{9},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := RewriteGo("main.go", []byte(tt.src), Accessor{Name: tt.accessor, Method: tt.method}, tt.flagType, tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(strings.Split(tt.expected, "\n"), strings.Split(string(result.Source), "\n")); diff != "" {
+				t.Errorf("unexpected source (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.remaining, result.Remaining); diff != "" {
+				t.Errorf("unexpected remaining references (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRewriteGoCompiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a module")
+	}
+	files := map[string]string{
+		"go.mod": "module example.com/app\n\ngo 1.23\n",
+		"flags/flags.go": `package flags
+
+import "context"
+
+var NewFlow = struct {
+	Value func(ctx context.Context, evalCtx map[string]any) (bool, error)
+}{
+	Value: func(context.Context, map[string]any) (bool, error) { return false, nil },
+}
+
+var MaxItems = struct {
+	Value func(ctx context.Context, evalCtx map[string]any) (int64, error)
+}{
+	Value: func(context.Context, map[string]any) (int64, error) { return 10, nil },
+}
+
+type Client struct{}
+
+func NewDomainClient(domain string) *Client {
+	return &Client{}
+}
+
+func (c *Client) NewFlow(ctx context.Context, evalCtx map[string]any) (bool, error) {
+	return false, nil
+}
+`,
+		"main.go": `package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"example.com/app/flags"
+)
+
+func main() {
+	ctx := context.Background()
+	msg := fmt.Sprintf("%d items", len(items()))
+	var fallback = errors.New("old flow")
+	total := 0
+	for i, item := range items() {
+		total += item
+		log.Print(i)
+	}
+	newFlow, err := flags.NewFlow.Value(ctx, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if !newFlow {
+		log.Print(msg, total, fallback)
+		return
+	}
+	log.Print("new flow")
+
+	client := flags.NewDomainClient("checkout")
+	if on, _ := client.NewFlow(ctx, nil); on {
+		log.Print("new flow of the checkout domain")
+	}
+
+	limit, _ := flags.MaxItems.Value(ctx, nil)
+	batch := limit
+	process(batch)
+	fmt.Println(limit)
+}
+
+func items() []int {
+	return []int{1, 2}
+}
+
+func process(n int64) {
+	log.Print(n)
+}
+`,
+	}
+	// The source compiles before the rewrite
+	build(t, files)
+
+	result, err := RewriteGo("main.go", []byte(files["main.go"]), Accessor{Name: "NewFlow", Method: "NewFlow"}, flagset.BoolType, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files["main.go"] = string(result.Source)
+	build(t, files)
+
+	result, err = RewriteGo("main.go", []byte(files["main.go"]), Accessor{Name: "MaxItems", Method: "MaxItems"}, flagset.IntType, float64(25))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files["main.go"] = string(result.Source)
+	build(t, files)
+}
+
+// build compiles the files as a module, skipping the test if the go command isn't available
+func build(t *testing.T, files map[string]string) {
+	t.Helper()
+	goCmd, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("the go command is not available")
+	}
+
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := exec.Command(goCmd, "build", "./...")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod", "GOTOOLCHAIN=local")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("error building:\n%s\nmain.go:\n%s", output, files["main.go"])
+	}
+}
+
+func TestRewriteGoUnchanged(t *testing.T) {
+	src := `package main
+
+func run() {
+	use(OtherFlag.Value(ctx, nil))
+}
+`
+	result, err := RewriteGo("main.go", []byte(src), Accessor{Name: "EnableFeatureA", Method: "EnableFeatureA"}, flagset.BoolType, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Source != nil || result.Rewritten != 0 || len(result.Remaining) != 0 {
+		t.Errorf("expected the file to be unchanged, got %+v", result)
+	}
+}
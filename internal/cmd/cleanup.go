@@ -0,0 +1,279 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/open-feature/cli/internal/cleanup"
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+	"github.com/open-feature/cli/internal/generators/golang"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/open-feature/cli/internal/usage"
+	"github.com/pterm/pterm"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func GetCleanupCmd() *cobra.Command {
+	cleanupCmd := &cobra.Command{
+		Use:   "cleanup <key>",
+		Short: "Remove a retired flag from the manifest and the Go code",
+		Long: `Remove a retired flag from the manifest, the manifests it includes and the overlays
+of its environments, and regenerate the accessors.
+
+The evaluations of the generated Go accessors, e.g. EnableFeatureA.Value(ctx, evalCtx) or
+client.EnableFeatureA(ctx, evalCtx) on a Client, are replaced by the final value of the flag
+and a nil error, and the resulting code is simplified: variables holding them are inlined,
+constant conditions are folded and if statements with a constant condition are replaced by
+the branch that is taken. The final value is the default value of the flag, unless --value is set.
+
+The generators set by --regenerate are run after the flag is removed, with the output and the
+options configured for them in the generate section of the .openfeature config file. Without it,
+the generators configured there are run. Generators without a configured output are rejected.
+
+The touched files are listed for review, along with the references to the accessor that
+couldn't be rewritten, e.g. calls of ValueWithDetails. Code in other languages is not
+rewritten, run openfeature usage to find its references.`,
+		Example: `openfeature cleanup enableFeatureA
+openfeature cleanup enableFeatureA --value true --source ./src --regenerate go`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "cleanup")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key := args[0]
+			manifestPath := config.GetManifestPath(cmd)
+			rawValue := config.GetCleanupValue(cmd)
+			source := config.GetCleanupSource(cmd)
+			exclude := config.GetExclude(cmd)
+			generatorNames := config.GetCleanupRegenerate(cmd)
+
+			fs, err := flagset.Load(manifestPath, "")
+			if err != nil {
+				return err
+			}
+			var flag *flagset.Flag
+			for i := range fs.Flags {
+				if fs.Flags[i].Key == key {
+					flag = &fs.Flags[i]
+					break
+				}
+			}
+			if flag == nil {
+				return fmt.Errorf("flag %q is not defined in the manifest", key)
+			}
+			value, err := finalValue(*flag, rawValue)
+			if err != nil {
+				return err
+			}
+
+			v, err := readConfig()
+			if err != nil {
+				return err
+			}
+			if len(generatorNames) == 0 {
+				generatorNames = configuredGenerators(v)
+			}
+			var generateArgs [][]string
+			for _, name := range generatorNames {
+				if _, ok := generators.DefaultManager.GetAll()[name]; !ok {
+					return fmt.Errorf("unknown generator %q", name)
+				}
+				args, err := generatorArgs(v, manifestPath, name)
+				if err != nil {
+					return err
+				}
+				generateArgs = append(generateArgs, args)
+			}
+
+			// Compute all changes before writing any of them, so that a failure leaves the files untouched.
+			manifestFiles, err := manifest.RemoveFlag(manifestPath, key)
+			if err != nil {
+				return err
+			}
+
+			var sourceFiles []manifest.File
+			var remaining []string
+			if name, ok := golang.Accessors(fs)[key]; ok {
+				accessor := cleanup.Accessor{Name: name, Method: golang.ClientMethods(fs)[key]}
+				err = usage.Walk(source, exclude, []string{".go"}, func(file string, data []byte) error {
+					path := filepath.Join(source, file)
+					result, err := cleanup.RewriteGo(path, data, accessor, flag.Type, value)
+					if err != nil {
+						// A file that doesn't compile, e.g. test data, shouldn't stop the cleanup
+						logger.Default.Warning(err.Error())
+						return nil
+					}
+					if result.Source != nil {
+						sourceFiles = append(sourceFiles, manifest.File{Path: path, Data: result.Source})
+					}
+					for _, line := range result.Remaining {
+						remaining = append(remaining, fmt.Sprintf("%s:%d", path, line))
+					}
+					return nil
+				})
+				if err != nil {
+					return fmt.Errorf("error rewriting %q: %w", source, err)
+				}
+			}
+
+			var touched []string
+			for _, file := range append(manifestFiles, sourceFiles...) {
+				if err := filesystem.WriteFile(file.Path, file.Data); err != nil {
+					logger.Default.FileFailed(file.Path, err)
+					return err
+				}
+				logger.Default.FileUpdated(file.Path)
+				touched = append(touched, file.Path)
+			}
+
+			if len(generatorNames) > 0 {
+				generated, err := regenerate(generateArgs)
+				touched = append(touched, generated...)
+				if err != nil {
+					return err
+				}
+			}
+			if len(generatorNames) == 0 {
+				logger.Default.Info("No generators are configured, regenerate the accessors with openfeature generate.")
+			}
+
+			pterm.Println()
+			pterm.Info.Println("Touched files, review them before committing:")
+			for _, path := range touched {
+				pterm.Println("  " + path)
+			}
+			if len(remaining) > 0 {
+				pterm.Println()
+				pterm.Warning.Printf("%d reference(s) to the accessor of %s couldn't be rewritten, remove them by hand:\n", len(remaining), key)
+				for _, location := range remaining {
+					pterm.Println("  " + location)
+				}
+			}
+			logger.Default.Success(fmt.Sprintf("Removed flag %s.", key))
+			return nil
+		},
+	}
+
+	config.AddCleanupFlags(cleanupCmd)
+
+	addStabilityInfo(cleanupCmd)
+
+	return cleanupCmd
+}
+
+// finalValue returns the value the evaluations of the flag are replaced by: the JSON value,
+// or the default value of the flag if it is empty. Strings may also be given without quotes.
+func finalValue(flag flagset.Flag, raw string) (any, error) {
+	if raw == "" {
+		return flag.DefaultValue, nil
+	}
+
+	var value any
+	err := json.Unmarshal([]byte(raw), &value)
+	if flag.Type == flagset.StringType {
+		if s, ok := value.(string); ok && err == nil {
+			return s, nil
+		}
+		return raw, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %q: %w", raw, err)
+	}
+
+	valid := false
+	switch v := value.(type) {
+	case bool:
+		valid = flag.Type == flagset.BoolType
+	case float64:
+		valid = flag.Type == flagset.FloatType || flag.Type == flagset.IntType && v == float64(int64(v))
+	case map[string]any:
+		valid = flag.Type == flagset.ObjectType
+	}
+	if !valid {
+		return nil, fmt.Errorf("value %s is not a valid value of the %s flag %s", raw, flag.Type.ManifestType(), flag.Key)
+	}
+	return value, nil
+}
+
+// generatorArgs returns the arguments of the generate command running the generator on the manifest,
+// with the output and the options configured for the generator. The output has to be configured,
+// so that the accessors aren't generated into the current directory.
+func generatorArgs(v *viper.Viper, manifestPath, name string) ([]string, error) {
+	generatorCmd, _, err := GetRootCmd().Find([]string{"generate", name})
+	if err != nil {
+		return nil, err
+	}
+	bindPrefix := "generate." + name
+	output, ok := configValue(v, bindPrefix, config.OutputFlagName)
+	if !ok {
+		return nil, fmt.Errorf("the output of the %s generator is not configured, set %s.%s in the config file",
+			name, bindPrefix, config.OutputFlagName)
+	}
+
+	args := []string{"generate", name, "--" + config.ManifestFlagName, manifestPath, "--" + config.OutputFlagName, output}
+	generatorCmd.LocalFlags().VisitAll(func(f *pflag.Flag) {
+		if value, ok := configValue(v, bindPrefix, f.Name); ok {
+			args = append(args, fmt.Sprintf("--%s=%s", f.Name, value))
+		}
+	})
+	return args, nil
+}
+
+// configValue returns the configured value of the flag, looked up like initializeConfig does
+func configValue(v *viper.Viper, bindPrefix, name string) (string, bool) {
+	for _, path := range configPaths(bindPrefix, name) {
+		if v.IsSet(path) {
+			return fmt.Sprintf("%v", v.Get(path)), true
+		}
+	}
+	return "", false
+}
+
+// regenerate runs the generate commands, returning the paths of the files they wrote
+func regenerate(generateArgs [][]string) ([]string, error) {
+	fs := filesystem.FileSystem()
+	recorder := &recordingFs{Fs: fs}
+	filesystem.SetFileSystem(recorder)
+	defer filesystem.SetFileSystem(fs)
+
+	for _, args := range generateArgs {
+		generateCmd := GetRootCmd()
+		generateCmd.SetArgs(args)
+		if err := generateCmd.Execute(); err != nil {
+			return recorder.created, fmt.Errorf("error regenerating the %s accessors: %w", args[1], err)
+		}
+	}
+	return recorder.created, nil
+}
+
+// recordingFs records the paths of the files created through it
+type recordingFs struct {
+	afero.Fs
+	created []string
+}
+
+func (r *recordingFs) Create(name string) (afero.File, error) {
+	r.created = append(r.created, name)
+	return r.Fs.Create(name)
+}
+
+// configuredGenerators returns the generators that have a section in the generate section of the config file
+func configuredGenerators(v *viper.Viper) []string {
+	var names []string
+	for name := range v.GetStringMap("generate") {
+		if _, ok := generators.DefaultManager.GetAll()[name]; ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/spf13/afero"
+)
+
+func TestCleanupCmd(t *testing.T) {
+	originalDir, tmpDir := setupConfigFileForTest(t, `
+generate:
+  go:
+    output: generated
+    package-name: flags
+`)
+	defer func() {
+		_ = os.Chdir(originalDir)
+		_ = os.RemoveAll(tmpDir)
+	}()
+	setupListTest(t)
+	fs := filesystem.FileSystem()
+	source := `package main
+
+func main() {
+	if on, _ := flags.EnableFeatureA.Value(ctx, evalCtx); on {
+		newFlow()
+	} else {
+		oldFlow()
+	}
+}
+`
+	if err := afero.WriteFile(fs, "src/main.go", []byte(source), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := GetCleanupCmd()
+	config.AddRootFlags(cmd)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs([]string{"enableFeatureA", "-m", "flags.json", "--source", "src", "--value", "true", "--regenerate", "go"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	flags, err := flagset.Load("flags.json", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, flag := range flags.Flags {
+		if flag.Key == "enableFeatureA" {
+			t.Error("expected enableFeatureA to be removed from the manifest")
+		}
+	}
+
+	rewritten, err := afero.ReadFile(fs, "src/main.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(rewritten), "EnableFeatureA") || strings.Contains(string(rewritten), "oldFlow") ||
+		!strings.Contains(string(rewritten), "\tnewFlow()") {
+		t.Errorf("expected the taken branch to be inlined, got:\n%s", rewritten)
+	}
+
+	generated, err := afero.ReadFile(fs, "generated/flags.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(generated), "EnableFeatureA") || !strings.Contains(string(generated), "MaxItems") ||
+		!strings.Contains(string(generated), "package flags") {
+		t.Errorf("expected the accessors to be regenerated without EnableFeatureA, got:\n%s", generated)
+	}
+}
+
+func TestCleanupCmdRequiresConfiguredOutput(t *testing.T) {
+	originalDir, tmpDir := setupConfigFileForTest(t, `
+generate:
+  go:
+    package-name: flags
+`)
+	defer func() {
+		_ = os.Chdir(originalDir)
+		_ = os.RemoveAll(tmpDir)
+	}()
+	setupListTest(t)
+
+	cmd := GetCleanupCmd()
+	config.AddRootFlags(cmd)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs([]string{"enableFeatureA", "-m", "flags.json", "--regenerate", "go"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "generate.go.output") {
+		t.Errorf("expected an error about the output of the go generator, got %v", err)
+	}
+	manifest, err := afero.ReadFile(filesystem.FileSystem(), "flags.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if manifest := string(manifest); manifest != listTestManifest {
+		t.Errorf("expected the manifest to be unchanged, got:\n%s", manifest)
+	}
+	if exists, _ := afero.Exists(filesystem.FileSystem(), "openfeature.go"); exists {
+		t.Error("expected no accessors to be generated into the current directory")
+	}
+}
+
+func TestCleanupCmdInvalidValue(t *testing.T) {
+	setupListTest(t)
+
+	cmd := GetCleanupCmd()
+	config.AddRootFlags(cmd)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs([]string{"maxItems", "-m", "flags.json", "--value", "1.5", "--regenerate", "go"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "not a valid value") {
+		t.Errorf("expected an invalid value error, got %v", err)
+	}
+}
@@ -3,7 +3,6 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/open-feature/cli/internal/config"
@@ -26,6 +25,7 @@ func GetCompareCmd() *cobra.Command {
 			sourcePath := config.GetManifestPath(cmd)
 			targetPath, _ := cmd.Flags().GetString("against")
 			outputFormat, _ := cmd.Flags().GetString("output")
+			env := config.GetEnvironment(cmd)
 
 			// Validate flags
 			if sourcePath == "" || targetPath == "" {
@@ -39,12 +39,12 @@ func GetCompareCmd() *cobra.Command {
 			}
 
 			// Load manifests
-			sourceManifest, err := loadManifest(sourcePath)
+			sourceManifest, err := manifest.Load(sourcePath, env)
 			if err != nil {
 				return fmt.Errorf("error loading source manifest: %w", err)
 			}
 
-			targetManifest, err := loadManifest(targetPath)
+			targetManifest, err := manifest.Load(targetPath, env)
 			if err != nil {
 				return fmt.Errorf("error loading target manifest: %w", err)
 			}
@@ -79,6 +79,7 @@ func GetCompareCmd() *cobra.Command {
 	compareCmd.Flags().StringP("against", "a", "", "Path to the target manifest file to compare against")
 	compareCmd.Flags().StringP("output", "o", string(manifest.OutputFormatTree),
 		fmt.Sprintf("Output format. Valid formats: %s", strings.Join(manifest.GetValidOutputFormats(), ", ")))
+	config.AddCompareFlags(compareCmd)
 
 	// Mark required flags
 	_ = compareCmd.MarkFlagRequired("against")
@@ -86,23 +87,6 @@ func GetCompareCmd() *cobra.Command {
 	return compareCmd
 }
 
-// loadManifest loads and unmarshals a manifest file from the given path
-func loadManifest(path string) (*manifest.Manifest, error) {
-	// Read file
-	data, err := os.ReadFile(path)
-	if err != nil {
-		return nil, fmt.Errorf("error reading file: %w", err)
-	}
-
-	// Unmarshal JSON
-	var m manifest.Manifest
-	if err := json.Unmarshal(data, &m); err != nil {
-		return nil, fmt.Errorf("error unmarshaling JSON: %w", err)
-	}
-
-	return &m, nil
-}
-
 // renderTreeDiff renders changes with tree-structured inline differences
 func renderTreeDiff(changes []manifest.Change, cmd *cobra.Command) error {
 	pterm.Info.Printf("Found %d difference(s) between manifests:\n\n", len(changes))
@@ -129,8 +113,7 @@ func renderTreeDiff(changes []manifest.Change, cmd *cobra.Command) error {
 	if len(additions) > 0 {
 		pterm.FgGreen.Println("◆ Additions:")
 		for _, change := range additions {
-			flagName := strings.TrimPrefix(change.Path, "flags.")
-			pterm.FgGreen.Printf("  + %s\n", flagName)
+			pterm.FgGreen.Printf("  + %s\n", changeName(change))
 			valueJSON, _ := json.MarshalIndent(change.NewValue, "    ", "  ")
 			fmt.Printf("    %s\n", valueJSON)
 		}
@@ -141,8 +124,7 @@ func renderTreeDiff(changes []manifest.Change, cmd *cobra.Command) error {
 	if len(removals) > 0 {
 		pterm.FgRed.Println("◆ Removals:")
 		for _, change := range removals {
-			flagName := strings.TrimPrefix(change.Path, "flags.")
-			pterm.FgRed.Printf("  - %s\n", flagName)
+			pterm.FgRed.Printf("  - %s\n", changeName(change))
 			valueJSON, _ := json.MarshalIndent(change.OldValue, "    ", "  ")
 			fmt.Printf("    %s\n", valueJSON)
 		}
@@ -153,8 +135,7 @@ func renderTreeDiff(changes []manifest.Change, cmd *cobra.Command) error {
 	if len(modifications) > 0 {
 		pterm.FgYellow.Println("◆ Modifications:")
 		for _, change := range modifications {
-			flagName := strings.TrimPrefix(change.Path, "flags.")
-			pterm.FgYellow.Printf("  ~ %s\n", flagName)
+			pterm.FgYellow.Printf("  ~ %s\n", changeName(change))
 
 			// Marshall the values
 			oldJSON, _ := json.MarshalIndent(change.OldValue, "", "  ")
@@ -176,12 +157,21 @@ func renderTreeDiff(changes []manifest.Change, cmd *cobra.Command) error {
 	return nil
 }
 
+// changeName returns the key of the changed flag, followed by the file it is defined in, if known
+func changeName(change manifest.Change) string {
+	flagName := strings.TrimPrefix(change.Path, "flags.")
+	if change.Source == "" {
+		return flagName
+	}
+	return fmt.Sprintf("%s (%s)", flagName, change.Source)
+}
+
 // renderFlatDiff renders changes in a flat format
 func renderFlatDiff(changes []manifest.Change, cmd *cobra.Command) error {
 	pterm.Info.Printf("Found %d difference(s) between manifests:\n\n", len(changes))
 
 	for _, change := range changes {
-		flagName := strings.TrimPrefix(change.Path, "flags.")
+		flagName := changeName(change)
 		switch change.Type {
 		case "add":
 			pterm.FgGreen.Printf("+ %s\n", flagName)
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -28,6 +30,7 @@ func TestCompareManifests(t *testing.T) {
 	// with each of the supported output formats
 
 	formats := []string{"tree", "flat", "json", "yaml"}
+	filesystem.SetFileSystem(afero.NewOsFs())
 
 	for _, format := range formats {
 		t.Run(fmt.Sprintf("output_format_%s", format), func(t *testing.T) {
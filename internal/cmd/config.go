@@ -13,23 +13,9 @@ import (
 // initializeConfig reads in config file and ENV variables if set.
 // It applies configuration values to command flags based on hierarchical priority.
 func initializeConfig(cmd *cobra.Command, bindPrefix string) error {
-	v := viper.New()
-
-	// Set the config file name and path
-	v.SetConfigName(".openfeature")
-	v.AddConfigPath(".")
-
-	logger.Default.Debug("Looking for .openfeature config file in current directory")
-
-	// Read the config file
-	if err := v.ReadInConfig(); err != nil {
-		// It's okay if there isn't a config file
-		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
-			return err
-		}
-		logger.Default.Debug("No config file found, using defaults and environment variables")
-	} else {
-		logger.Default.Debug(fmt.Sprintf("Using config file: %s", v.ConfigFileUsed()))
+	v, err := readConfig()
+	if err != nil {
+		return err
 	}
 
 	// Track which flags were set directly via command line
@@ -47,28 +33,12 @@ func initializeConfig(cmd *cobra.Command, bindPrefix string) error {
 			return
 		}
 
-		// Build configuration paths from most specific to least specific
-		configPaths := []string{}
-
-		// Check the most specific path (e.g., generate.go.package-name)
-		if bindPrefix != "" {
-			configPaths = append(configPaths, bindPrefix+"."+f.Name)
-
-			// Check parent paths (e.g., generate.package-name)
-			parts := strings.Split(bindPrefix, ".")
-			for i := len(parts) - 1; i > 0; i-- {
-				parentPath := strings.Join(parts[:i], ".") + "." + f.Name
-				configPaths = append(configPaths, parentPath)
-			}
-		}
-
-		// Check the base path (e.g., package-name)
-		configPaths = append(configPaths, f.Name)
+		paths := configPaths(bindPrefix, f.Name)
 
-		logger.Default.Debug(fmt.Sprintf("Looking for config value for flag %s in paths: %s", f.Name, strings.Join(configPaths, ", ")))
+		logger.Default.Debug(fmt.Sprintf("Looking for config value for flag %s in paths: %s", f.Name, strings.Join(paths, ", ")))
 
 		// Try each path in order until we find a match
-		for _, path := range configPaths {
+		for _, path := range paths {
 			if v.IsSet(path) {
 				val := v.Get(path)
 				err := f.Value.Set(fmt.Sprintf("%v", val))
@@ -87,3 +57,47 @@ func initializeConfig(cmd *cobra.Command, bindPrefix string) error {
 
 	return nil
 }
+
+// configPaths returns the configuration paths of the flag, from most specific to least specific
+func configPaths(bindPrefix, name string) []string {
+	paths := []string{}
+
+	// Check the most specific path (e.g., generate.go.package-name)
+	if bindPrefix != "" {
+		paths = append(paths, bindPrefix+"."+name)
+
+		// Check parent paths (e.g., generate.package-name)
+		parts := strings.Split(bindPrefix, ".")
+		for i := len(parts) - 1; i > 0; i-- {
+			paths = append(paths, strings.Join(parts[:i], ".")+"."+name)
+		}
+	}
+
+	// Check the base path (e.g., package-name)
+	return append(paths, name)
+}
+
+// readConfig reads the .openfeature config file in the current directory.
+// A missing config file results in an empty configuration.
+func readConfig() (*viper.Viper, error) {
+	v := viper.New()
+
+	// Set the config file name and path
+	v.SetConfigName(".openfeature")
+	v.AddConfigPath(".")
+
+	logger.Default.Debug("Looking for .openfeature config file in current directory")
+
+	// Read the config file
+	if err := v.ReadInConfig(); err != nil {
+		// It's okay if there isn't a config file
+		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+			return nil, err
+		}
+		logger.Default.Debug("No config file found, using defaults and environment variables")
+	} else {
+		logger.Default.Debug(fmt.Sprintf("Using config file: %s", v.ConfigFileUsed()))
+	}
+
+	return v, nil
+}
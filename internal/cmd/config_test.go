@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/open-feature/cli/internal/config"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 )
@@ -170,3 +171,23 @@ generate:
 	assert.Equal(t, "output-from-cmdline", cmd.Flag("output").Value.String(),
 		"Command line value should override config file")
 }
+
+func TestPHPNamespaceIgnoresCSharpNamespace(t *testing.T) {
+	configContent := `
+generate:
+  namespace: MyCompany.Flags
+`
+	originalDir, tmpDir := setupConfigFileForTest(t, configContent)
+	defer func() {
+		_ = os.Chdir(originalDir)
+		_ = os.RemoveAll(tmpDir)
+	}()
+
+	phpCmd := &cobra.Command{Use: "php"}
+	config.AddPHPGenerateFlags(phpCmd)
+	err := initializeConfig(phpCmd, "generate.php")
+
+	assert.NoError(t, err)
+	assert.Equal(t, config.DefaultPHPNamespace, config.GetPHPNamespace(phpCmd),
+		"PHP command should not get the C# namespace")
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+func GetFormatCmd() *cobra.Command {
+	formatCmd := &cobra.Command{
+		Use:   "fmt [files...]",
+		Short: "Format flag manifests",
+		Long: `Rewrite flag manifests into their canonical form.
+
+The canonical form has a normalized $schema, the properties of flags in the order
+flagType, defaultValue, description, and a consistent indentation. The order of the
+flags is preserved, unless --sort-keys is set. JSON and YAML manifests are supported.
+
+Without arguments, the manifest and the manifests it includes are formatted.
+The includes of a YAML manifest are not resolved.`,
+		Example: `openfeature fmt
+openfeature fmt --check
+openfeature fmt --sort-keys --indent 4 flags.json`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "fmt")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options := config.GetFormatOptions(cmd)
+			check := config.GetFormatCheck(cmd)
+
+			paths := args
+			if manifestPath := config.GetManifestPath(cmd); len(paths) == 0 && manifest.IsYAML(manifestPath) {
+				// Includes are only resolved for JSON manifests.
+				paths = []string{manifestPath}
+			} else if len(paths) == 0 {
+				files, err := manifest.ReadFiles(manifestPath)
+				if err != nil {
+					return err
+				}
+				for _, file := range files {
+					paths = append(paths, file.Path)
+				}
+			}
+
+			var unformatted []string
+			for _, path := range paths {
+				data, err := afero.ReadFile(filesystem.FileSystem(), path)
+				if err != nil {
+					return fmt.Errorf("error reading contents from file %q", path)
+				}
+				formatted, err := manifest.Format(path, data, options)
+				if err != nil {
+					return fmt.Errorf("error formatting %q: %w", path, err)
+				}
+				if bytes.Equal(formatted, data) {
+					continue
+				}
+
+				unformatted = append(unformatted, path)
+				if check {
+					logger.Default.Warning(fmt.Sprintf("%s is not formatted", path))
+					continue
+				}
+				if err := filesystem.WriteFile(path, formatted); err != nil {
+					logger.Default.FileFailed(path, err)
+					return err
+				}
+				logger.Default.FileUpdated(path)
+			}
+
+			if check && len(unformatted) > 0 {
+				return fmt.Errorf("%d file(s) are not formatted, run openfeature fmt to format them", len(unformatted))
+			}
+			if len(unformatted) == 0 {
+				logger.Default.Success("All files are formatted.")
+			}
+			return nil
+		},
+	}
+
+	config.AddFormatFlags(formatCmd)
+
+	return formatCmd
+}
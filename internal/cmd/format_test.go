@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+)
+
+func TestFormatCmd(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	unformatted := `{"flags": {"a": {"description": "A", "defaultValue": true, "flagType": "boolean"}}}`
+	if err := afero.WriteFile(fs, "flags.json", []byte(unformatted), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	run := func(args ...string) error {
+		cmd := GetFormatCmd()
+		config.AddRootFlags(cmd)
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetArgs(append([]string{"-m", "flags.json"}, args...))
+		return cmd.Execute()
+	}
+
+	if err := run("--check"); err == nil {
+		t.Fatal("expected --check to fail for an unformatted manifest")
+	}
+	data, _ := afero.ReadFile(fs, "flags.json")
+	if string(data) != unformatted {
+		t.Fatalf("expected --check to leave the manifest unchanged, got:\n%s", data)
+	}
+
+	if err := run(); err != nil {
+		t.Fatal(err)
+	}
+	expected := `{
+  "$schema": "https://raw.githubusercontent.com/open-feature/cli/main/schema/v0/flag-manifest.json",
+  "flags": {
+    "a": {
+      "flagType": "boolean",
+      "defaultValue": true,
+      "description": "A"
+    }
+  }
+}
+`
+	data, _ = afero.ReadFile(fs, "flags.json")
+	if string(data) != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, data)
+	}
+
+	if err := run("--check"); err != nil {
+		t.Errorf("expected --check to pass for a formatted manifest, got %v", err)
+	}
+}
@@ -6,13 +6,21 @@ import (
 	"github.com/open-feature/cli/internal/config"
 	"github.com/open-feature/cli/internal/flagset"
 	"github.com/open-feature/cli/internal/generators"
+	"github.com/open-feature/cli/internal/generators/angular"
 	"github.com/open-feature/cli/internal/generators/csharp"
+	"github.com/open-feature/cli/internal/generators/dart"
 	"github.com/open-feature/cli/internal/generators/golang"
 	"github.com/open-feature/cli/internal/generators/java"
 	"github.com/open-feature/cli/internal/generators/nestjs"
+	"github.com/open-feature/cli/internal/generators/nextjs"
 	"github.com/open-feature/cli/internal/generators/nodejs"
+	"github.com/open-feature/cli/internal/generators/php"
 	"github.com/open-feature/cli/internal/generators/python"
 	"github.com/open-feature/cli/internal/generators/react"
+	"github.com/open-feature/cli/internal/generators/ruby"
+	"github.com/open-feature/cli/internal/generators/svelte"
+	"github.com/open-feature/cli/internal/generators/vue"
+	"github.com/open-feature/cli/internal/generators/web"
 	"github.com/open-feature/cli/internal/logger"
 	"github.com/spf13/cobra"
 )
@@ -21,6 +29,12 @@ func GetGenerateCmd() *cobra.Command {
 	generateCmd := &cobra.Command{
 		Use:   "generate",
 		Short: "Generate typesafe OpenFeature accessors.",
+		Long: `Generate typesafe OpenFeature accessors.
+
+With --env, the default values are overridden by the overlay of the environment, read from the
+environments of the manifest or from the sidecar file <manifest>.<env>.json, e.g. flags.production.json
+for flags.json. Files with that name that aren't overlays, such as copies of the manifest or JSON schemas,
+are ignored.`,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			return initializeConfig(cmd, "generate")
 		},
@@ -85,9 +99,11 @@ func getGenerateNodeJSCmd() *cobra.Command {
 
 			params := generators.Params[nodejs.Params]{
 				OutputPath: outputPath,
-				Custom:     nodejs.Params{},
+				Custom: nodejs.Params{
+					TestHelpers: config.GetTestHelpers(cmd),
+				},
 			}
-			flagset, err := flagset.Load(manifestPath)
+			flagset, err := flagset.Load(manifestPath, config.GetEnvironment(cmd))
 			if err != nil {
 				return err
 			}
@@ -105,6 +121,8 @@ func getGenerateNodeJSCmd() *cobra.Command {
 		},
 	}
 
+	config.AddTestHelperFlags(nodeJSCmd)
+
 	addStabilityInfo(nodeJSCmd)
 
 	return nodeJSCmd
@@ -129,9 +147,11 @@ func getGenerateReactCmd() *cobra.Command {
 
 			params := generators.Params[react.Params]{
 				OutputPath: outputPath,
-				Custom:     react.Params{},
+				Custom: react.Params{
+					TestHelpers: config.GetTestHelpers(cmd),
+				},
 			}
-			flagset, err := flagset.Load(manifestPath)
+			flagset, err := flagset.Load(manifestPath, config.GetEnvironment(cmd))
 			if err != nil {
 				return err
 			}
@@ -149,6 +169,8 @@ func getGenerateReactCmd() *cobra.Command {
 		},
 	}
 
+	config.AddTestHelperFlags(reactCmd)
+
 	addStabilityInfo(reactCmd)
 
 	return reactCmd
@@ -171,7 +193,7 @@ func GetGenerateNestJsCmd() *cobra.Command {
 
 			logger.Default.GenerationStarted("NestJS")
 
-			flagset, err := flagset.Load(manifestPath)
+			flagset, err := flagset.Load(manifestPath, config.GetEnvironment(cmd))
 			if err != nil {
 				return err
 			}
@@ -229,10 +251,12 @@ func getGenerateCSharpCmd() *cobra.Command {
 			params := generators.Params[csharp.Params]{
 				OutputPath: outputPath,
 				Custom: csharp.Params{
-					Namespace: namespace,
+					Namespace:   namespace,
+					TestHelpers: config.GetTestHelpers(cmd),
+					LoggingHook: config.GetCSharpLoggingHook(cmd),
 				},
 			}
-			flagset, err := flagset.Load(manifestPath)
+			flagset, err := flagset.Load(manifestPath, config.GetEnvironment(cmd))
 			if err != nil {
 				return err
 			}
@@ -252,6 +276,7 @@ func getGenerateCSharpCmd() *cobra.Command {
 
 	// Add C#-specific flags
 	config.AddCSharpGenerateFlags(csharpCmd)
+	config.AddTestHelperFlags(csharpCmd)
 
 	addStabilityInfo(csharpCmd)
 
@@ -280,10 +305,12 @@ func getGenerateJavaCmd() *cobra.Command {
 				OutputPath: outputPath,
 				Custom: java.Params{
 					JavaPackage: javaPackageName,
+					TestHelpers: config.GetTestHelpers(cmd),
+					Spring:      config.GetJavaSpring(cmd),
 				},
 			}
 
-			flagset, err := flagset.Load(manifestPath)
+			flagset, err := flagset.Load(manifestPath, config.GetEnvironment(cmd))
 			if err != nil {
 				return err
 			}
@@ -304,6 +331,7 @@ func getGenerateJavaCmd() *cobra.Command {
 
 	// Add Java specific flags
 	config.AddJavaGenerateFlags(javaCmd)
+	config.AddTestHelperFlags(javaCmd)
 
 	addStabilityInfo(javaCmd)
 
@@ -331,11 +359,12 @@ func getGenerateGoCmd() *cobra.Command {
 			params := generators.Params[golang.Params]{
 				OutputPath: outputPath,
 				Custom: golang.Params{
-					GoPackage: goPackageName,
+					GoPackage:   goPackageName,
+					TestHelpers: config.GetTestHelpers(cmd),
 				},
 			}
 
-			flagset, err := flagset.Load(manifestPath)
+			flagset, err := flagset.Load(manifestPath, config.GetEnvironment(cmd))
 			if err != nil {
 				return err
 			}
@@ -355,6 +384,7 @@ func getGenerateGoCmd() *cobra.Command {
 
 	// Add Go-specific flags
 	config.AddGoGenerateFlags(goCmd)
+	config.AddTestHelperFlags(goCmd)
 
 	addStabilityInfo(goCmd)
 
@@ -369,6 +399,9 @@ func getGeneratePythonCmd() *cobra.Command {
 		Annotations: map[string]string{
 			"stability": string(generators.Alpha),
 		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "generate.python")
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			manifestPath := config.GetManifestPath(cmd)
 			outputPath := config.GetOutputPath(cmd)
@@ -377,9 +410,14 @@ func getGeneratePythonCmd() *cobra.Command {
 
 			params := generators.Params[python.Params]{
 				OutputPath: outputPath,
-				Custom:     python.Params{},
+				Custom: python.Params{
+					TestHelpers: config.GetTestHelpers(cmd),
+					ModuleName:  config.GetPythonModuleName(cmd),
+					Package:     config.GetPythonPackage(cmd),
+					API:         config.GetPythonAPI(cmd),
+				},
 			}
-			flagset, err := flagset.Load(manifestPath)
+			flagset, err := flagset.Load(manifestPath, config.GetEnvironment(cmd))
 			if err != nil {
 				return err
 			}
@@ -397,11 +435,388 @@ func getGeneratePythonCmd() *cobra.Command {
 		},
 	}
 
+	config.AddPythonGenerateFlags(pythonCmd)
+	config.AddTestHelperFlags(pythonCmd)
+
 	addStabilityInfo(pythonCmd)
 
 	return pythonCmd
 }
 
+func getGeneratePHPCmd() *cobra.Command {
+	phpCmd := &cobra.Command{
+		Use:   "php",
+		Short: "Generate typesafe PHP client.",
+		Long:  `Generate typesafe PSR-4 PHP client compatible with the OpenFeature PHP SDK.`,
+		Annotations: map[string]string{
+			"stability": string(generators.Alpha),
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "generate.php")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace := config.GetPHPNamespace(cmd)
+			manifestPath := config.GetManifestPath(cmd)
+			outputPath := config.GetOutputPath(cmd)
+
+			logger.Default.GenerationStarted("PHP")
+
+			params := generators.Params[php.Params]{
+				OutputPath: outputPath,
+				Custom: php.Params{
+					Namespace: namespace,
+				},
+			}
+			flagset, err := flagset.Load(manifestPath, config.GetEnvironment(cmd))
+			if err != nil {
+				return err
+			}
+
+			generator := php.NewGenerator(flagset)
+			logger.Default.Debug("Executing PHP generator")
+			err = generator.Generate(&params)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.GenerationComplete("PHP")
+
+			return nil
+		},
+	}
+
+	// Add PHP-specific flags
+	config.AddPHPGenerateFlags(phpCmd)
+
+	addStabilityInfo(phpCmd)
+
+	return phpCmd
+}
+
+func getGenerateRubyCmd() *cobra.Command {
+	rubyCmd := &cobra.Command{
+		Use:   "ruby",
+		Short: "Generate typesafe Ruby module.",
+		Long:  `Generate typesafe Ruby module and RBS signatures compatible with the OpenFeature Ruby SDK.`,
+		Annotations: map[string]string{
+			"stability": string(generators.Alpha),
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "generate.ruby")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			moduleName := config.GetRubyModuleName(cmd)
+			manifestPath := config.GetManifestPath(cmd)
+			outputPath := config.GetOutputPath(cmd)
+
+			logger.Default.GenerationStarted("Ruby")
+
+			params := generators.Params[ruby.Params]{
+				OutputPath: outputPath,
+				Custom: ruby.Params{
+					ModuleName: moduleName,
+				},
+			}
+			flagset, err := flagset.Load(manifestPath, config.GetEnvironment(cmd))
+			if err != nil {
+				return err
+			}
+
+			generator := ruby.NewGenerator(flagset)
+			logger.Default.Debug("Executing Ruby generator")
+			err = generator.Generate(&params)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.GenerationComplete("Ruby")
+
+			return nil
+		},
+	}
+
+	// Add Ruby-specific flags
+	config.AddRubyGenerateFlags(rubyCmd)
+
+	addStabilityInfo(rubyCmd)
+
+	return rubyCmd
+}
+
+func getGenerateDartCmd() *cobra.Command {
+	dartCmd := &cobra.Command{
+		Use:   "dart",
+		Short: "Generate typesafe Dart client.",
+		Long: `Generate typesafe Dart client and optional Flutter widgets compatible with the OpenFeature Dart server SDK.
+
+OpenFeature has no client SDK for Dart, so the Flutter widgets are built on the server SDK as well:
+every rebuild evaluates the flags through the provider with the dynamic context paradigm,
+and only providers of the server SDK that support the platforms of the app can be used.`,
+		Annotations: map[string]string{
+			"stability": string(generators.Alpha),
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "generate.dart")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flutter := config.GetDartFlutter(cmd)
+			manifestPath := config.GetManifestPath(cmd)
+			outputPath := config.GetOutputPath(cmd)
+
+			logger.Default.GenerationStarted("Dart")
+
+			params := generators.Params[dart.Params]{
+				OutputPath: outputPath,
+				Custom: dart.Params{
+					Flutter: flutter,
+				},
+			}
+			flagset, err := flagset.Load(manifestPath, config.GetEnvironment(cmd))
+			if err != nil {
+				return err
+			}
+
+			generator := dart.NewGenerator(flagset)
+			logger.Default.Debug("Executing Dart generator")
+			err = generator.Generate(&params)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.GenerationComplete("Dart")
+
+			return nil
+		},
+	}
+
+	// Add Dart-specific flags
+	config.AddDartGenerateFlags(dartCmd)
+
+	addStabilityInfo(dartCmd)
+
+	return dartCmd
+}
+
+func getGenerateAngularCmd() *cobra.Command {
+	angularCmd := &cobra.Command{
+		Use:   "angular",
+		Short: "Generate typesafe Angular services and directives.",
+		Long:  `Generate a typesafe Angular service and structural directives compatible with the OpenFeature Angular SDK.`,
+		Annotations: map[string]string{
+			"stability": string(generators.Alpha),
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "generate.angular")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			outputPath := config.GetOutputPath(cmd)
+
+			logger.Default.GenerationStarted("Angular")
+
+			params := generators.Params[angular.Params]{
+				OutputPath: outputPath,
+				Custom:     angular.Params{},
+			}
+			flagset, err := flagset.Load(manifestPath, config.GetEnvironment(cmd))
+			if err != nil {
+				return err
+			}
+
+			generator := angular.NewGenerator(flagset)
+			logger.Default.Debug("Executing Angular generator")
+			err = generator.Generate(&params)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.GenerationComplete("Angular")
+
+			return nil
+		},
+	}
+
+	addStabilityInfo(angularCmd)
+
+	return angularCmd
+}
+
+func getGenerateVueCmd() *cobra.Command {
+	vueCmd := &cobra.Command{
+		Use:   "vue",
+		Short: "Generate typesafe Vue composables.",
+		Long:  `Generate typesafe Vue composables that update on provider events, compatible with the OpenFeature Web SDK.`,
+		Annotations: map[string]string{
+			"stability": string(generators.Alpha),
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "generate.vue")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			outputPath := config.GetOutputPath(cmd)
+
+			logger.Default.GenerationStarted("Vue")
+
+			params := generators.Params[vue.Params]{
+				OutputPath: outputPath,
+				Custom:     vue.Params{},
+			}
+			flagset, err := flagset.Load(manifestPath, config.GetEnvironment(cmd))
+			if err != nil {
+				return err
+			}
+
+			generator := vue.NewGenerator(flagset)
+			logger.Default.Debug("Executing Vue generator")
+			err = generator.Generate(&params)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.GenerationComplete("Vue")
+
+			return nil
+		},
+	}
+
+	addStabilityInfo(vueCmd)
+
+	return vueCmd
+}
+
+func getGenerateSvelteCmd() *cobra.Command {
+	svelteCmd := &cobra.Command{
+		Use:   "svelte",
+		Short: "Generate typesafe Svelte stores.",
+		Long:  `Generate typesafe Svelte stores that update on provider events, compatible with the OpenFeature Web SDK.`,
+		Annotations: map[string]string{
+			"stability": string(generators.Alpha),
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "generate.svelte")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			outputPath := config.GetOutputPath(cmd)
+
+			logger.Default.GenerationStarted("Svelte")
+
+			params := generators.Params[svelte.Params]{
+				OutputPath: outputPath,
+				Custom:     svelte.Params{},
+			}
+			flagset, err := flagset.Load(manifestPath, config.GetEnvironment(cmd))
+			if err != nil {
+				return err
+			}
+
+			generator := svelte.NewGenerator(flagset)
+			logger.Default.Debug("Executing Svelte generator")
+			err = generator.Generate(&params)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.GenerationComplete("Svelte")
+
+			return nil
+		},
+	}
+
+	addStabilityInfo(svelteCmd)
+
+	return svelteCmd
+}
+
+func getGenerateWebCmd() *cobra.Command {
+	webCmd := &cobra.Command{
+		Use:   "web",
+		Short: "Generate typesafe Web client.",
+		Long:  `Generate typesafe Web client compatible with the OpenFeature JavaScript Web SDK.`,
+		Annotations: map[string]string{
+			"stability": string(generators.Alpha),
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "generate.web")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			outputPath := config.GetOutputPath(cmd)
+
+			logger.Default.GenerationStarted("Web")
+
+			params := generators.Params[web.Params]{
+				OutputPath: outputPath,
+				Custom:     web.Params{},
+			}
+			flagset, err := flagset.Load(manifestPath, config.GetEnvironment(cmd))
+			if err != nil {
+				return err
+			}
+
+			generator := web.NewGenerator(flagset)
+			logger.Default.Debug("Executing Web generator")
+			err = generator.Generate(&params)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.GenerationComplete("Web")
+
+			return nil
+		},
+	}
+
+	addStabilityInfo(webCmd)
+
+	return webCmd
+}
+
+func getGenerateNextjsCmd() *cobra.Command {
+	nextjsCmd := &cobra.Command{
+		Use:   "nextjs",
+		Short: "Generate typesafe Next.js server accessors and client hooks.",
+		Long:  `Generate typesafe Next.js server accessors compatible with the OpenFeature JavaScript Server SDK and client hooks compatible with the OpenFeature React SDK.`,
+		Annotations: map[string]string{
+			"stability": string(generators.Alpha),
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "generate.nextjs")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			outputPath := config.GetOutputPath(cmd)
+
+			logger.Default.GenerationStarted("Next.js")
+
+			params := generators.Params[nextjs.Params]{
+				OutputPath: outputPath,
+				Custom:     nextjs.Params{},
+			}
+			flagset, err := flagset.Load(manifestPath, config.GetEnvironment(cmd))
+			if err != nil {
+				return err
+			}
+
+			generator := nextjs.NewGenerator(flagset)
+			logger.Default.Debug("Executing Next.js generator")
+			err = generator.Generate(&params)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.GenerationComplete("Next.js")
+
+			return nil
+		},
+	}
+
+	addStabilityInfo(nextjsCmd)
+
+	return nextjsCmd
+}
+
 func init() {
 	// Register generators with the manager
 	generators.DefaultManager.Register(getGenerateReactCmd)
@@ -411,4 +826,29 @@ func init() {
 	generators.DefaultManager.Register(getGenerateCSharpCmd)
 	generators.DefaultManager.Register(GetGenerateNestJsCmd)
 	generators.DefaultManager.Register(getGenerateJavaCmd)
+	generators.DefaultManager.Register(getGeneratePHPCmd)
+	generators.DefaultManager.Register(getGenerateRubyCmd)
+	generators.DefaultManager.Register(getGenerateDartCmd)
+	generators.DefaultManager.Register(getGenerateAngularCmd)
+	generators.DefaultManager.Register(getGenerateVueCmd)
+	generators.DefaultManager.Register(getGenerateSvelteCmd)
+	generators.DefaultManager.Register(getGenerateWebCmd)
+	generators.DefaultManager.Register(getGenerateNextjsCmd)
+
+	// Register the accessor names of the generators, e.g. for the show command
+	generators.DefaultManager.RegisterAccessors("react", react.Accessors)
+	generators.DefaultManager.RegisterAccessors("go", golang.Accessors)
+	generators.DefaultManager.RegisterAccessors("nodejs", nodejs.Accessors)
+	generators.DefaultManager.RegisterAccessors("python", python.Accessors)
+	generators.DefaultManager.RegisterAccessors("csharp", csharp.Accessors)
+	generators.DefaultManager.RegisterAccessors("nestjs", nestjs.Accessors)
+	generators.DefaultManager.RegisterAccessors("java", java.Accessors)
+	generators.DefaultManager.RegisterAccessors("php", php.Accessors)
+	generators.DefaultManager.RegisterAccessors("ruby", ruby.Accessors)
+	generators.DefaultManager.RegisterAccessors("dart", dart.Accessors)
+	generators.DefaultManager.RegisterAccessors("angular", angular.Accessors)
+	generators.DefaultManager.RegisterAccessors("vue", vue.Accessors)
+	generators.DefaultManager.RegisterAccessors("svelte", svelte.Accessors)
+	generators.DefaultManager.RegisterAccessors("web", web.Accessors)
+	generators.DefaultManager.RegisterAccessors("nextjs", nextjs.Accessors)
 }
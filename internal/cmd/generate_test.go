@@ -15,13 +15,14 @@ import (
 
 // generateTestCase holds the configuration for each generate test
 type generateTestCase struct {
-	name           string // test case name
-	command        string // generator to run
-	manifestGolden string // path to the golden manifest file
-	outputGolden   string // path to the golden output file
-	outputPath     string // output directory (optional, defaults to "output")
-	outputFile     string // output file name
-	packageName    string // optional, used for Go (package-name), Java (package-name) and C# (namespace)
+	name           string   // test case name
+	command        string   // generator to run
+	manifestGolden string   // path to the golden manifest file
+	outputGolden   string   // path to the golden output file
+	outputPath     string   // output directory (optional, defaults to "output")
+	outputFile     string   // output file name
+	packageName    string   // optional, used for Go (package-name), Java (package-name), C# (namespace), PHP (php-namespace) and Ruby (module-name)
+	extraArgs      []string // optional, additional generator specific arguments
 }
 
 func TestGenerate(t *testing.T) {
@@ -60,7 +61,7 @@ func TestGenerate(t *testing.T) {
 			command:        "python",
 			manifestGolden: "testdata/success_manifest.golden",
 			outputGolden:   "testdata/success_python.golden",
-			outputFile:     "openfeature.py",
+			outputFile:     "openfeature_flags.py",
 		},
 		{
 			name:           "CSharp generation success",
@@ -78,6 +79,312 @@ func TestGenerate(t *testing.T) {
 			outputFile:     "OpenFeature.java",
 			packageName:    "com.example.openfeature",
 		},
+		{
+			name:           "PHP generation success",
+			command:        "php",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_php.golden",
+			outputFile:     "GeneratedClient.php",
+			packageName:    `App\Flags`, // Using packageName field for namespace
+		},
+		{
+			name:           "Ruby generation success",
+			command:        "ruby",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_ruby.golden",
+			outputFile:     "test_flags.rb",
+			packageName:    "TestFlags",
+		},
+		{
+			name:           "Ruby RBS generation success",
+			command:        "ruby",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_ruby_rbs.golden",
+			outputFile:     "sig/test_flags.rbs",
+			packageName:    "TestFlags",
+		},
+		{
+			name:           "Dart generation success",
+			command:        "dart",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_dart.golden",
+			outputFile:     "openfeature.g.dart",
+		},
+		{
+			name:           "Dart Flutter widgets generation success",
+			command:        "dart",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_flutter.golden",
+			outputFile:     "openfeature_widgets.g.dart",
+			extraArgs:      []string{"--flutter"},
+		},
+		{
+			name:           "Angular generation success",
+			command:        "angular",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_angular.golden",
+			outputFile:     "openfeature.ts",
+		},
+		{
+			name:           "Vue generation success",
+			command:        "vue",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_vue.golden",
+			outputFile:     "openfeature.ts",
+		},
+		{
+			name:           "Svelte generation success",
+			command:        "svelte",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_svelte.golden",
+			outputFile:     "openfeature.ts",
+		},
+		{
+			name:           "Web generation success",
+			command:        "web",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_web.golden",
+			outputFile:     "openfeature.ts",
+		},
+		{
+			name:           "Next.js server generation success",
+			command:        "nextjs",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_nextjs_server.golden",
+			outputFile:     "openfeature.server.ts",
+		},
+		{
+			name:           "Next.js client generation success",
+			command:        "nextjs",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_nextjs_client.golden",
+			outputFile:     "openfeature.client.tsx",
+		},
+		{
+			name:           "Go test helpers generation success",
+			command:        "go",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_go_testflags.golden",
+			outputFile:     "testflags/testflags.go",
+			packageName:    "testpackage",
+			extraArgs:      []string{"--test-helpers"},
+		},
+		{
+			name:           "NodeJS test helpers generation success",
+			command:        "nodejs",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_nodejs_testing.golden",
+			outputFile:     "openfeature.testing.ts",
+			extraArgs:      []string{"--test-helpers"},
+		},
+		{
+			name:           "React test helpers generation success",
+			command:        "react",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_react_testing.golden",
+			outputFile:     "openfeature.testing.tsx",
+			extraArgs:      []string{"--test-helpers"},
+		},
+		{
+			name:           "Python test helpers generation success",
+			command:        "python",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_python_testing.golden",
+			outputFile:     "openfeature_flags_testing.py",
+			extraArgs:      []string{"--test-helpers"},
+		},
+		{
+			name:           "CSharp test helpers generation success",
+			command:        "csharp",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_csharp_testing.golden",
+			outputFile:     "OpenFeatureTestFlags.g.cs",
+			packageName:    "TestNamespace",
+			extraArgs:      []string{"--test-helpers"},
+		},
+		{
+			name:           "Java test helpers generation success",
+			command:        "java",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_java_testing.golden",
+			outputFile:     "OpenFeatureTestFlags.java",
+			packageName:    "com.example.openfeature",
+			extraArgs:      []string{"--test-helpers"},
+		},
+		{
+			name:           "Java Spring flag key generation success",
+			command:        "java",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_java_flagkey.golden",
+			outputFile:     "FlagKey.java",
+			packageName:    "com.example.openfeature",
+			extraArgs:      []string{"--spring"},
+		},
+		{
+			name:           "Java Spring configuration generation success",
+			command:        "java",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_java_spring_configuration.golden",
+			outputFile:     "OpenFeatureConfiguration.java",
+			packageName:    "com.example.openfeature",
+			extraArgs:      []string{"--spring"},
+		},
+		{
+			name:           "Java Spring conditional generation success",
+			command:        "java",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_java_spring_conditional.golden",
+			outputFile:     "ConditionalOnFeatureFlag.java",
+			packageName:    "com.example.openfeature",
+			extraArgs:      []string{"--spring"},
+		},
+		{
+			name:           "Python sync-only generation success",
+			command:        "python",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_python_sync.golden",
+			outputFile:     "openfeature_flags.py",
+			extraArgs:      []string{"--api", "sync"},
+		},
+		{
+			name:           "Python async-only package generation success",
+			command:        "python",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_python_async_package.golden",
+			outputFile:     "feature_flags/__init__.py",
+			extraArgs:      []string{"--package", "--python-module", "feature_flags", "--api", "async"},
+		},
+		{
+			name:           "Python package stub generation success",
+			command:        "python",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_python_pyi.golden",
+			outputFile:     "feature_flags/__init__.pyi",
+			extraArgs:      []string{"--package", "--python-module", "feature_flags"},
+		},
+		{
+			name:           "CSharp logging hook generation success",
+			command:        "csharp",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_csharp_logging.golden",
+			outputFile:     "OpenFeatureLoggingHook.g.cs",
+			packageName:    "TestNamespace",
+			extraArgs:      []string{"--logging-hook"},
+		},
+		{
+			name:           "Go escaping generation success",
+			command:        "go",
+			manifestGolden: "testdata/escaping_manifest.golden",
+			outputGolden:   "testdata/success_escaping_go.golden",
+			outputFile:     "openfeature.go",
+		},
+		{
+			name:           "NodeJS escaping generation success",
+			command:        "nodejs",
+			manifestGolden: "testdata/escaping_manifest.golden",
+			outputGolden:   "testdata/success_escaping_nodejs.golden",
+			outputFile:     "openfeature.ts",
+		},
+		{
+			name:           "Java escaping generation success",
+			command:        "java",
+			manifestGolden: "testdata/escaping_manifest.golden",
+			outputGolden:   "testdata/success_escaping_java.golden",
+			outputFile:     "OpenFeature.java",
+		},
+		{
+			name:           "CSharp escaping generation success",
+			command:        "csharp",
+			manifestGolden: "testdata/escaping_manifest.golden",
+			outputGolden:   "testdata/success_escaping_csharp.golden",
+			outputFile:     "OpenFeature.g.cs",
+		},
+		{
+			name:           "Python escaping generation success",
+			command:        "python",
+			manifestGolden: "testdata/escaping_manifest.golden",
+			outputGolden:   "testdata/success_escaping_python.golden",
+			outputFile:     "openfeature_flags.py",
+		},
+		{
+			name:           "PHP escaping generation success",
+			command:        "php",
+			manifestGolden: "testdata/escaping_manifest.golden",
+			outputGolden:   "testdata/success_escaping_php.golden",
+			outputFile:     "GeneratedClient.php",
+		},
+		{
+			name:           "Ruby escaping generation success",
+			command:        "ruby",
+			manifestGolden: "testdata/escaping_manifest.golden",
+			outputGolden:   "testdata/success_escaping_ruby.golden",
+			outputFile:     "open_feature_flags.rb",
+		},
+		{
+			name:           "Dart escaping generation success",
+			command:        "dart",
+			manifestGolden: "testdata/escaping_manifest.golden",
+			outputGolden:   "testdata/success_escaping_dart.golden",
+			outputFile:     "openfeature.g.dart",
+		},
+		{
+			name:           "Go grouped generation success",
+			command:        "go",
+			manifestGolden: "testdata/grouped_manifest.golden",
+			outputGolden:   "testdata/success_grouped_go.golden",
+			outputFile:     "openfeature.go",
+		},
+		{
+			name:           "NodeJS grouped generation success",
+			command:        "nodejs",
+			manifestGolden: "testdata/grouped_manifest.golden",
+			outputGolden:   "testdata/success_grouped_nodejs.golden",
+			outputFile:     "openfeature.ts",
+		},
+		{
+			name:           "Java grouped generation success",
+			command:        "java",
+			manifestGolden: "testdata/grouped_manifest.golden",
+			outputGolden:   "testdata/success_grouped_java.golden",
+			outputFile:     "OpenFeature.java",
+			packageName:    "com.example.openfeature",
+		},
+		{
+			name:           "Python grouped generation success",
+			command:        "python",
+			manifestGolden: "testdata/grouped_manifest.golden",
+			outputGolden:   "testdata/success_grouped_python.golden",
+			outputFile:     "openfeature_flags.py",
+		},
+		{
+			name:           "Go environment overlay generation success",
+			command:        "go",
+			manifestGolden: "testdata/environment_manifest.golden",
+			outputGolden:   "testdata/success_environment_go.golden",
+			outputFile:     "openfeature.go",
+			extraArgs:      []string{"--env", "production"},
+		},
+		{
+			name:           "Go context builder generation success",
+			command:        "go",
+			manifestGolden: "testdata/context_manifest.golden",
+			outputGolden:   "testdata/success_context_go.golden",
+			outputFile:     "openfeature.go",
+		},
+		{
+			name:           "NodeJS context type generation success",
+			command:        "nodejs",
+			manifestGolden: "testdata/context_manifest.golden",
+			outputGolden:   "testdata/success_context_nodejs.golden",
+			outputFile:     "openfeature.ts",
+		},
+		{
+			name:           "React context type generation success",
+			command:        "react",
+			manifestGolden: "testdata/context_manifest.golden",
+			outputGolden:   "testdata/success_context_react.golden",
+			outputFile:     "openfeature.ts",
+		},
 		// Add more test cases here as needed
 	}
 
@@ -113,13 +420,19 @@ func TestGenerate(t *testing.T) {
 			if tc.packageName != "" {
 				if tc.command == "csharp" {
 					args = append(args, "--namespace", tc.packageName)
+				} else if tc.command == "php" {
+					args = append(args, "--php-namespace", tc.packageName)
 				} else if tc.command == "go" {
 					args = append(args, "--package-name", tc.packageName)
 				} else if tc.command == "java" {
 					args = append(args, "--package-name", tc.packageName)
+				} else if tc.command == "ruby" {
+					args = append(args, "--module-name", tc.packageName)
 				}
 			}
 
+			args = append(args, tc.extraArgs...)
+
 			cmd.SetArgs(args)
 
 			// Run command
@@ -134,6 +447,101 @@ func TestGenerate(t *testing.T) {
 	}
 }
 
+func TestGenerateIdentifierCollision(t *testing.T) {
+	for _, command := range []string{"go", "nodejs", "python", "java", "csharp"} {
+		t.Run(command, func(t *testing.T) {
+			cmd := GetGenerateCmd()
+			config.AddRootFlags(cmd)
+
+			const memoryManifestPath = "manifest/path.json"
+			fs := afero.NewMemMapFs()
+			filesystem.SetFileSystem(fs)
+			readOsFileAndWriteToMemMap(t, "testdata/collision_manifest.golden", memoryManifestPath, fs)
+
+			cmd.SetArgs([]string{command, "--manifest", memoryManifestPath, "--output", "output"})
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			err := cmd.Execute()
+			if err == nil {
+				t.Fatal("expected an error for colliding flag keys")
+			}
+			if !strings.Contains(err.Error(), `flag keys "foo-bar" and "foo_bar" both map to the identifier`) {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if exists, _ := afero.DirExists(fs, "output"); exists {
+				t.Error("expected no output to be generated")
+			}
+		})
+	}
+}
+
+func TestGenerateEscapesTemplateMembers(t *testing.T) {
+	testCases := []struct {
+		command    string
+		key        string
+		outputFile string
+		want       string
+	}{
+		{command: "angular", key: "constructor", outputFile: "openfeature.ts", want: "constructor_(domain?: string): Signal<boolean>"},
+		{command: "angular", key: "injector", outputFile: "openfeature.ts", want: "injector_(domain?: string): Signal<boolean>"},
+		{command: "dart", key: "client", outputFile: "openfeature.g.dart", want: "Future<bool> client_({EvaluationContext? context})"},
+		{command: "php", key: "create", outputFile: "GeneratedClient.php", want: "public function create_(?EvaluationContext $context = null"},
+		{command: "php", key: "list", outputFile: "GeneratedClient.php", want: "public function list_(?EvaluationContext $context = null"},
+		{command: "ruby", key: "client", outputFile: "open_feature_flags.rb", want: "def self.client_(evaluation_context: nil)"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.command+"/"+tc.key, func(t *testing.T) {
+			cmd := GetGenerateCmd()
+			config.AddRootFlags(cmd)
+
+			const memoryManifestPath = "manifest/path.json"
+			fs := afero.NewMemMapFs()
+			filesystem.SetFileSystem(fs)
+			manifest := `{"flags": {"` + tc.key + `": {"flagType": "boolean", "defaultValue": true, "description": "Clashes with the template."}}}`
+			if err := afero.WriteFile(fs, memoryManifestPath, []byte(manifest), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			cmd.SetArgs([]string{tc.command, "--manifest", memoryManifestPath, "--output", "output"})
+			if err := cmd.Execute(); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := afero.ReadFile(fs, filepath.Join("output", tc.outputFile))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(got), tc.want) {
+				t.Errorf("expected the generated code to contain %q, got:\n%s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGeneratePythonRejectsSDKModuleName(t *testing.T) {
+	cmd := GetGenerateCmd()
+	config.AddRootFlags(cmd)
+
+	const memoryManifestPath = "manifest/path.json"
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	readOsFileAndWriteToMemMap(t, "testdata/success_manifest.golden", memoryManifestPath, fs)
+
+	cmd.SetArgs([]string{"python", "--manifest", memoryManifestPath, "--output", "output", "--python-module", "openfeature", "--package"})
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "shadows the openfeature SDK package") {
+		t.Fatalf("expected the module name to be rejected, got %v", err)
+	}
+	if exists, _ := afero.DirExists(fs, "output"); exists {
+		t.Error("expected no output to be generated")
+	}
+}
+
 func readOsFileAndWriteToMemMap(t *testing.T, inputPath string, memPath string, memFs afero.Fs) {
 	data, err := os.ReadFile(inputPath)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"github.com/open-feature/cli/internal/filesystem"
 	"github.com/open-feature/cli/internal/logger"
 	"github.com/open-feature/cli/internal/manifest"
+	"github.com/open-feature/cli/internal/usage"
 	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
 )
@@ -15,7 +16,17 @@ func GetInitCmd() *cobra.Command {
 	initCmd := &cobra.Command{
 		Use:   "init",
 		Short: "Initialize a new project",
-		Long:  "Initialize a new project for OpenFeature CLI.",
+		Long: `Initialize a new project for OpenFeature CLI.
+
+With --from-source, a draft manifest is inferred from the OpenFeature SDK calls in a directory
+instead of creating an empty one. Go files are parsed, TypeScript, JavaScript, Java, Python
+and C# files are matched against the evaluation methods of their SDKs, e.g.
+getStringValue('key', 'x'). The key, the type and the default value of each flag are
+inferred from the calls with string literal keys. Go calls must have the signature of the
+SDK, a context, the flag key, the default value and the evaluation context. Calls that disagree on the type or the
+default value of a flag are listed in the conflicts field of its metadata for review.`,
+		Example: `openfeature init
+openfeature init --from-source ./src`,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return initializeConfig(cmd, "init")
 		},
@@ -38,6 +49,10 @@ func GetInitCmd() *cobra.Command {
 				logger.Default.Debug("User confirmed override of existing manifest")
 			}
 
+			if dir := config.GetInitFromSource(cmd); dir != "" {
+				return initFromSource(manifestPath, dir)
+			}
+
 			logger.Default.Info("Initializing project...")
 			err := manifest.Create(manifestPath)
 			if err != nil {
@@ -57,3 +72,43 @@ func GetInitCmd() *cobra.Command {
 
 	return initCmd
 }
+
+// initFromSource creates a draft manifest from the OpenFeature SDK calls in the directory
+func initFromSource(manifestPath, dir string) error {
+	logger.Default.Info(fmt.Sprintf("Searching %s for flag evaluations...", dir))
+	evaluations, err := usage.FindEvaluations(dir, nil)
+	if err != nil {
+		return fmt.Errorf("error searching %q: %w", dir, err)
+	}
+
+	drafts := usage.Draft(evaluations)
+	flags := make(map[string]any, len(drafts))
+	conflicts := 0
+	for _, draft := range drafts {
+		flag := map[string]any{
+			"flagType":     draft.Type.ManifestType(),
+			"defaultValue": draft.DefaultValue,
+		}
+		if len(draft.Conflicts) > 0 {
+			flag["metadata"] = map[string]any{"conflicts": draft.Conflicts}
+			for _, conflict := range draft.Conflicts {
+				logger.Default.Warning(fmt.Sprintf("%s: %s", draft.Key, conflict))
+			}
+			conflicts++
+		}
+		flags[draft.Key] = flag
+	}
+
+	if err := manifest.CreateDraft(manifestPath, flags); err != nil {
+		logger.Default.Error(fmt.Sprintf("Failed to create manifest: %v", err))
+		return err
+	}
+
+	logger.Default.FileCreated(manifestPath)
+	if conflicts > 0 {
+		logger.Default.Warning(fmt.Sprintf("%d of %d flag(s) have conflicts, review the conflicts in their metadata.", conflicts, len(drafts)))
+		return nil
+	}
+	logger.Default.Success(fmt.Sprintf("Found %d flag(s) in %d evaluation(s).", len(drafts), len(evaluations)))
+	return nil
+}
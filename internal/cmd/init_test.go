@@ -26,3 +26,34 @@ func TestInitCmd(t *testing.T) {
 	}
 	compareOutput(t, "testdata/success_init.golden", outputFile, fs)
 }
+
+func TestInitCmdFromSource(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	sources := map[string]string{
+		"src/main.go": `package main
+
+func main() {
+	enabled, _ := client.BooleanValue(ctx, "enableCheckout", false, evalCtx)
+	limit, _ := client.IntValue(ctx, "maxItems", 10, evalCtx)
+}
+`,
+		"src/app.ts": `const theme = await client.getStringValue('theme', 'dark');
+const limit = await client.getNumberValue("maxItems", 25);
+`,
+		"src/Service.java": `double ratio = client.getDoubleValue("discountRatio", rollout.ratio());` + "\n",
+	}
+	for path, content := range sources {
+		if err := afero.WriteFile(fs, path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := GetInitCmd()
+	config.AddRootFlags(cmd)
+	cmd.SetArgs([]string{"-m", "flags.json", "--from-source", "src"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	compareOutput(t, "testdata/success_init_from_source.golden", "flags.json", fs)
+}
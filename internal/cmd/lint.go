@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/lint"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func GetLintCmd() *cobra.Command {
+	lintCmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Check the flag manifest against conventions",
+		Long: `Check the flag manifest against conventions that the JSON schema can't express,
+such as naming conventions of flag keys or required descriptions.
+
+Rules are configured in the lint.rules section of the .openfeature config file.
+Each rule accepts a severity (error, warning or off) and its own options.
+A flag suppresses rules by listing their names in the lintIgnore field of its metadata.
+The command fails if any issue has the error severity.`,
+		Example: `openfeature lint
+openfeature lint --list-rules
+openfeature lint --output json`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "lint")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			listRules, _ := cmd.Flags().GetBool("list-rules")
+			if listRules {
+				return renderLintRules()
+			}
+
+			outputFormat, _ := cmd.Flags().GetString("output")
+			if !manifest.LintOutputFormats.IsValid(outputFormat) {
+				return fmt.Errorf("invalid output format: %s. Valid formats are: %s",
+					outputFormat, strings.Join(manifest.LintOutputFormats.Strings(), ", "))
+			}
+
+			v, err := readConfig()
+			if err != nil {
+				return err
+			}
+			var rules lint.Config
+			if err := v.UnmarshalKey("lint.rules", &rules); err != nil {
+				return fmt.Errorf("error reading lint configuration: %w", err)
+			}
+
+			m, err := manifest.Load(config.GetManifestPath(cmd), "")
+			if err != nil {
+				return fmt.Errorf("error loading manifest: %w", err)
+			}
+
+			issues, err := lint.DefaultRegistry.Run(m, rules)
+			if err != nil {
+				return err
+			}
+
+			switch manifest.OutputFormat(outputFormat) {
+			case manifest.OutputFormatJSON, manifest.OutputFormatYAML:
+				if err := renderStructured(cmd, manifest.OutputFormat(outputFormat), issues); err != nil {
+					return err
+				}
+			default:
+				if err := renderLintIssues(issues); err != nil {
+					return err
+				}
+			}
+
+			if lint.HasErrors(issues) {
+				return fmt.Errorf("the manifest has lint errors")
+			}
+			return nil
+		},
+	}
+
+	lintCmd.Flags().StringP("output", "o", string(manifest.OutputFormatTable),
+		fmt.Sprintf("Output format. Valid formats: %s", strings.Join(manifest.LintOutputFormats.Strings(), ", ")))
+	lintCmd.Flags().Bool("list-rules", false, "List the available rules")
+
+	addStabilityInfo(lintCmd)
+
+	return lintCmd
+}
+
+// renderLintIssues renders the issues as a table
+func renderLintIssues(issues []lint.Issue) error {
+	if len(issues) == 0 {
+		pterm.Success.Println("No lint issues found.")
+		return nil
+	}
+
+	tableData := [][]string{
+		{"Severity", "Flag", "Rule", "Message"},
+	}
+	for _, issue := range issues {
+		flag := issue.Flag
+		if issue.Source != "" {
+			flag = fmt.Sprintf("%s (%s)", flag, issue.Source)
+		}
+		tableData = append(tableData, []string{string(issue.Severity), flag, issue.Rule, issue.Message})
+	}
+	if err := pterm.DefaultTable.WithHasHeader().WithData(tableData).Render(); err != nil {
+		return err
+	}
+
+	pterm.Println()
+	pterm.Info.Printf("Found %d lint issue(s).\n", len(issues))
+	return nil
+}
+
+// renderLintRules renders the available rules as a table
+func renderLintRules() error {
+	tableData := [][]string{
+		{"Rule", "Description", "Severity", "Options"},
+	}
+	for _, info := range lint.DefaultRegistry.GetAll() {
+		severity := string(info.Severity)
+		if !info.Enabled {
+			severity += " (once configured)"
+		}
+		tableData = append(tableData, []string{info.Name, info.Description, severity, strings.Join(info.Options, ", ")})
+	}
+	return pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+}
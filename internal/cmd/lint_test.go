@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/lint"
+	"github.com/spf13/afero"
+)
+
+func TestLintCmd(t *testing.T) {
+	configContent := `
+lint:
+  rules:
+    required-metadata:
+      fields: [owner]
+`
+	originalDir, tmpDir := setupConfigFileForTest(t, configContent)
+	defer func() {
+		_ = os.Chdir(originalDir)
+		_ = os.RemoveAll(tmpDir)
+	}()
+	filesystem.SetFileSystem(afero.NewOsFs())
+
+	manifestContent := `{
+  "flags": {
+    "enableCheckout": {"flagType": "boolean", "defaultValue": false, "description": "Enables the new checkout", "owner": "payments"},
+    "legacy_banner": {"flagType": "string", "defaultValue": "", "metadata": {"lintIgnore": ["key-naming"]}}
+  }
+}`
+	if err := os.WriteFile("flags.json", []byte(manifestContent), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := GetLintCmd()
+	config.AddRootFlags(cmd)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs([]string{"-m", "flags.json", "--output", "json"})
+
+	if err := cmd.Execute(); err == nil {
+		t.Fatal("expected the lint errors to fail the command")
+	}
+
+	var issues []lint.Issue
+	if err := json.Unmarshal(out.Bytes(), &issues); err != nil {
+		t.Fatalf("error unmarshaling output %q: %v", out.String(), err)
+	}
+	expected := map[string]lint.Severity{
+		"description":       lint.SeverityWarning,
+		"required-metadata": lint.SeverityError,
+	}
+	if len(issues) != len(expected) {
+		t.Fatalf("expected %d issues, got %v", len(expected), issues)
+	}
+	for _, issue := range issues {
+		if issue.Flag != "legacy_banner" || expected[issue.Rule] != issue.Severity {
+			t.Errorf("unexpected issue %v", issue)
+		}
+	}
+}
@@ -0,0 +1,313 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// flagSummary is a flag as listed by the list command.
+type flagSummary struct {
+	Key          string   `json:"key" yaml:"key"`
+	Type         string   `json:"type" yaml:"type"`
+	DefaultValue any      `json:"defaultValue" yaml:"defaultValue"`
+	Description  string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Group        string   `json:"group,omitempty" yaml:"group,omitempty"`
+	Owner        string   `json:"owner,omitempty" yaml:"owner,omitempty"`
+	Tags         []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Source       string   `json:"source,omitempty" yaml:"source,omitempty"`
+}
+
+// flagDetails is a flag as shown by the show command.
+type flagDetails struct {
+	flagSummary `yaml:",inline"`
+	Metadata    map[string]any `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	// Environments are the default values overridden by the overlays of the environments
+	Environments map[string]any `json:"environments,omitempty" yaml:"environments,omitempty"`
+	// Accessors are the names of the generated accessors, keyed by generator
+	Accessors map[string]string `json:"accessors" yaml:"accessors"`
+}
+
+func newFlagSummary(flag flagset.Flag) flagSummary {
+	summary := flagSummary{
+		Key:          flag.Key,
+		Type:         flag.Type.ManifestType(),
+		DefaultValue: flag.DefaultValue,
+		Description:  flag.Description,
+		Group:        flag.Group,
+		Source:       flag.Source,
+	}
+	summary.Owner, _ = flag.Metadata["owner"].(string)
+	switch tags := flag.Metadata["tags"].(type) {
+	case string:
+		summary.Tags = []string{tags}
+	case []any:
+		for _, tag := range tags {
+			if s, ok := tag.(string); ok {
+				summary.Tags = append(summary.Tags, s)
+			}
+		}
+	}
+	return summary
+}
+
+func GetListCmd() *cobra.Command {
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the flags of the manifest",
+		Long: `List the flags of the manifest, optionally filtered by type, tag, owner or key.
+
+The owner and the tags of a flag are read from the owner and tags fields of its metadata.`,
+		Example: `openfeature list
+openfeature list --type boolean --owner payments
+openfeature list --key "checkout.*" --output csv`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "list")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat, _ := cmd.Flags().GetString("output")
+			flagType, _ := cmd.Flags().GetString("type")
+			tag, _ := cmd.Flags().GetString("tag")
+			owner, _ := cmd.Flags().GetString("owner")
+			keyPattern, _ := cmd.Flags().GetString("key")
+
+			if !manifest.ListOutputFormats.IsValid(outputFormat) {
+				return fmt.Errorf("invalid output format: %s. Valid formats are: %s",
+					outputFormat, strings.Join(manifest.ListOutputFormats.Strings(), ", "))
+			}
+			if flagType != "" && flagset.ParseFlagType(flagType) == flagset.UnknownFlagType {
+				return fmt.Errorf("invalid flag type: %s. Valid types are: boolean, string, integer, float, object", flagType)
+			}
+			if _, err := path.Match(keyPattern, ""); err != nil {
+				return fmt.Errorf("invalid key pattern %q: %w", keyPattern, err)
+			}
+
+			fs, err := flagset.Load(config.GetManifestPath(cmd), "")
+			if err != nil {
+				return err
+			}
+
+			flags := []flagSummary{}
+			for _, flag := range fs.Flags {
+				summary := newFlagSummary(flag)
+				if flagType != "" && flag.Type != flagset.ParseFlagType(flagType) {
+					continue
+				}
+				if owner != "" && summary.Owner != owner {
+					continue
+				}
+				if tag != "" && !containsString(summary.Tags, tag) {
+					continue
+				}
+				if keyPattern != "" {
+					if matched, _ := path.Match(keyPattern, flag.Key); !matched {
+						continue
+					}
+				}
+				flags = append(flags, summary)
+			}
+
+			switch manifest.OutputFormat(outputFormat) {
+			case manifest.OutputFormatJSON, manifest.OutputFormatYAML:
+				return renderStructured(cmd, manifest.OutputFormat(outputFormat), flags)
+			case manifest.OutputFormatCSV:
+				return renderFlagsCSV(cmd, flags)
+			default:
+				return renderFlagsTable(flags)
+			}
+		},
+	}
+
+	listCmd.Flags().StringP("output", "o", string(manifest.OutputFormatTable),
+		fmt.Sprintf("Output format. Valid formats: %s", strings.Join(manifest.ListOutputFormats.Strings(), ", ")))
+	listCmd.Flags().String("type", "", "Only list flags of the type, e.g. boolean")
+	listCmd.Flags().String("tag", "", "Only list flags with the tag")
+	listCmd.Flags().String("owner", "", "Only list flags of the owner")
+	listCmd.Flags().String("key", "", "Only list flags whose key matches the glob pattern, e.g. checkout.*")
+
+	addStabilityInfo(listCmd)
+
+	return listCmd
+}
+
+func GetShowCmd() *cobra.Command {
+	showCmd := &cobra.Command{
+		Use:   "show <key>",
+		Short: "Show the details of a flag",
+		Long: `Show everything known about a flag: its type, default value, description, metadata,
+the default values overridden by the environments of the manifest, its includes and its
+sidecar overlays, and the names of the accessors each generator generates for it.`,
+		Example: "openfeature show enableFeatureA",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "show")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key := args[0]
+			outputFormat, _ := cmd.Flags().GetString("output")
+			if !manifest.ShowOutputFormats.IsValid(outputFormat) {
+				return fmt.Errorf("invalid output format: %s. Valid formats are: %s",
+					outputFormat, strings.Join(manifest.ShowOutputFormats.Strings(), ", "))
+			}
+
+			manifestPath := config.GetManifestPath(cmd)
+			fs, err := flagset.Load(manifestPath, "")
+			if err != nil {
+				return err
+			}
+
+			var details *flagDetails
+			for _, flag := range fs.Flags {
+				if flag.Key == key {
+					details = &flagDetails{flagSummary: newFlagSummary(flag), Metadata: flag.Metadata}
+					break
+				}
+			}
+			if details == nil {
+				return fmt.Errorf("flag %q is not defined in the manifest", key)
+			}
+
+			files, err := manifest.ReadFiles(manifestPath)
+			if err != nil {
+				return err
+			}
+			details.Environments, err = manifest.EnvironmentDefaults(files, key)
+			if err != nil {
+				return err
+			}
+
+			details.Accessors = map[string]string{}
+			for generator, accessors := range generators.DefaultManager.GetAccessors(fs) {
+				if accessor, ok := accessors[key]; ok {
+					details.Accessors[generator] = accessor
+				}
+			}
+
+			if manifest.OutputFormat(outputFormat) != manifest.OutputFormatTable {
+				return renderStructured(cmd, manifest.OutputFormat(outputFormat), details)
+			}
+			return renderFlagDetails(details)
+		},
+	}
+
+	showCmd.Flags().StringP("output", "o", string(manifest.OutputFormatTable),
+		fmt.Sprintf("Output format. Valid formats: %s", strings.Join(manifest.ShowOutputFormats.Strings(), ", ")))
+
+	addStabilityInfo(showCmd)
+
+	return showCmd
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// formatValue formats a value for tables and CSV, encoding values other than strings as JSON
+func formatValue(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// renderFlagsTable renders the flags as a table
+func renderFlagsTable(flags []flagSummary) error {
+	if len(flags) == 0 {
+		pterm.Info.Println("No flags found.")
+		return nil
+	}
+
+	tableData := [][]string{
+		{"Key", "Type", "Default Value", "Description", "Owner", "Tags"},
+	}
+	for _, flag := range flags {
+		tableData = append(tableData, []string{
+			flag.Key, flag.Type, formatValue(flag.DefaultValue), flag.Description, flag.Owner, strings.Join(flag.Tags, ", "),
+		})
+	}
+	return pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+}
+
+// renderFlagsCSV renders the flags as CSV, joining tags by semicolons
+func renderFlagsCSV(cmd *cobra.Command, flags []flagSummary) error {
+	writer := csv.NewWriter(cmd.OutOrStdout())
+	records := [][]string{
+		{"key", "type", "defaultValue", "description", "group", "owner", "tags", "source"},
+	}
+	for _, flag := range flags {
+		records = append(records, []string{
+			flag.Key, flag.Type, formatValue(flag.DefaultValue), flag.Description, flag.Group, flag.Owner,
+			strings.Join(flag.Tags, ";"), flag.Source,
+		})
+	}
+	return writer.WriteAll(records)
+}
+
+// renderFlagDetails renders the details of a flag as tables
+func renderFlagDetails(details *flagDetails) error {
+	tableData := [][]string{
+		{"Key", details.Key},
+		{"Type", details.Type},
+		{"Default Value", formatValue(details.DefaultValue)},
+	}
+	optional := [][]string{
+		{"Description", details.Description},
+		{"Group", details.Group},
+		{"Source", details.Source},
+	}
+	for _, row := range optional {
+		if row[1] != "" {
+			tableData = append(tableData, row)
+		}
+	}
+	for _, name := range sortedKeys(details.Metadata) {
+		tableData = append(tableData, []string{"Metadata " + name, formatValue(details.Metadata[name])})
+	}
+	for _, env := range sortedKeys(details.Environments) {
+		tableData = append(tableData, []string{"Default Value (" + env + ")", formatValue(details.Environments[env])})
+	}
+	if err := pterm.DefaultTable.WithData(tableData).Render(); err != nil {
+		return err
+	}
+	pterm.Println()
+
+	if len(details.Accessors) == 0 {
+		pterm.Info.Println("No generator supports the type of the flag.")
+		return nil
+	}
+	accessorData := [][]string{
+		{"Generator", "Accessor"},
+	}
+	for _, generator := range sortedKeys(details.Accessors) {
+		accessorData = append(accessorData, []string{generator, details.Accessors[generator]})
+	}
+	return pterm.DefaultTable.WithHasHeader().WithData(accessorData).Render()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+)
+
+const listTestManifest = `{
+  "flags": {
+    "enableFeatureA": {"flagType": "boolean", "defaultValue": false, "description": "Enables A", "owner": "payments", "tags": ["checkout", "beta"]},
+    "checkout.newFlow": {"flagType": "boolean", "defaultValue": true, "metadata": {"owner": "payments", "tags": "checkout"}},
+    "maxItems": {"flagType": "integer", "defaultValue": 10, "description": "Max, items"}
+  },
+  "environments": {
+    "production": {"maxItems": {"defaultValue": 100}}
+  }
+}`
+
+func setupListTest(t *testing.T) {
+	t.Helper()
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	if err := afero.WriteFile(fs, "flags.json", []byte(listTestManifest), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestListCmd(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "type and owner",
+			args:     []string{"--type", "boolean", "--owner", "payments", "-o", "csv"},
+			expected: "key,type,defaultValue,description,group,owner,tags,source\ncheckout.newFlow,boolean,true,,,payments,checkout,\nenableFeatureA,boolean,false,Enables A,,payments,checkout;beta,\n",
+		},
+		{
+			name:     "tag",
+			args:     []string{"--tag", "beta", "-o", "csv"},
+			expected: "key,type,defaultValue,description,group,owner,tags,source\nenableFeatureA,boolean,false,Enables A,,payments,checkout;beta,\n",
+		},
+		{
+			name:     "key glob",
+			args:     []string{"--key", "max*", "-o", "csv"},
+			expected: "key,type,defaultValue,description,group,owner,tags,source\nmaxItems,integer,10,\"Max, items\",,,,\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupListTest(t)
+
+			cmd := GetListCmd()
+			config.AddRootFlags(cmd)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetArgs(append([]string{"-m", "flags.json"}, tt.args...))
+
+			if err := cmd.Execute(); err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != tt.expected {
+				t.Errorf("expected output:\n%s\ngot:\n%s", tt.expected, out.String())
+			}
+		})
+	}
+}
+
+func TestListCmdInvalidType(t *testing.T) {
+	setupListTest(t)
+
+	cmd := GetListCmd()
+	config.AddRootFlags(cmd)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs([]string{"-m", "flags.json", "--type", "number"})
+
+	if err := cmd.Execute(); err == nil {
+		t.Fatal("expected an invalid type to fail the command")
+	}
+}
+
+func TestShowCmd(t *testing.T) {
+	setupListTest(t)
+
+	cmd := GetShowCmd()
+	config.AddRootFlags(cmd)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs([]string{"maxItems", "-m", "flags.json", "-o", "json"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	var details struct {
+		Key          string            `json:"key"`
+		Type         string            `json:"type"`
+		Environments map[string]any    `json:"environments"`
+		Accessors    map[string]string `json:"accessors"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &details); err != nil {
+		t.Fatalf("error unmarshaling output %q: %v", out.String(), err)
+	}
+	if details.Key != "maxItems" || details.Type != "integer" {
+		t.Errorf("unexpected flag %s of type %s", details.Key, details.Type)
+	}
+	if details.Environments["production"] != float64(100) {
+		t.Errorf("expected the production override 100, got %v", details.Environments["production"])
+	}
+	expectedAccessors := map[string]string{
+		"go":     "MaxItems",
+		"python": "max_items",
+		"react":  "useMaxItems",
+		"csharp": "MaxItemsAsync",
+	}
+	for generator, accessor := range expectedAccessors {
+		if details.Accessors[generator] != accessor {
+			t.Errorf("expected the %s accessor %s, got %s", generator, accessor, details.Accessors[generator])
+		}
+	}
+}
+
+func TestShowCmdOverlays(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	files := map[string]string{
+		"flags.json":         `{"includes": ["team.json"], "flags": {}}`,
+		"team.json":          `{"flags": {"maxItems": {"flagType": "integer", "defaultValue": 10}}, "environments": {"qa": {"maxItems": {"defaultValue": 5}}}}`,
+		"flags.staging.json": `{"flags": {"maxItems": {"defaultValue": 50}}}`,
+	}
+	for path, content := range files {
+		if err := afero.WriteFile(fs, path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := GetShowCmd()
+	config.AddRootFlags(cmd)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs([]string{"maxItems", "-m", "flags.json", "-o", "json"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	var details struct {
+		Environments map[string]any `json:"environments"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &details); err != nil {
+		t.Fatalf("error unmarshaling output %q: %v", out.String(), err)
+	}
+	expected := map[string]any{"qa": float64(5), "staging": float64(50)}
+	if len(details.Environments) != len(expected) {
+		t.Errorf("expected the overrides %v, got %v", expected, details.Environments)
+	}
+	for env, value := range expected {
+		if details.Environments[env] != value {
+			t.Errorf("expected the %s override %v, got %v", env, value, details.Environments[env])
+		}
+	}
+}
+
+func TestShowCmdUnknownFlag(t *testing.T) {
+	setupListTest(t)
+
+	cmd := GetShowCmd()
+	config.AddRootFlags(cmd)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs([]string{"unknown", "-m", "flags.json"})
+
+	if err := cmd.Execute(); err == nil {
+		t.Fatal("expected an unknown flag to fail the command")
+	}
+}
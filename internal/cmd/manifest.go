@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/cobra"
+)
+
+func GetManifestCmd() *cobra.Command {
+	manifestCmd := &cobra.Command{
+		Use:   "manifest",
+		Short: "Manage flag manifests",
+		Long:  "Commands for managing OpenFeature flag manifests.",
+	}
+
+	manifestCmd.AddCommand(GetManifestMigrateCmd())
+
+	return manifestCmd
+}
+
+func GetManifestMigrateCmd() *cobra.Command {
+	migrateCmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate a flag manifest to a newer schema version",
+		Long: `Migrate a flag manifest, and the manifests it includes, to a newer schema version.
+
+The manifests are rewritten in place without losing any information: the order of their
+properties and all values are kept. Migrating to v1 adds the version, points a versioned
+$schema URL to the v1 schema and moves custom properties of flags into their metadata.`,
+		Example: "openfeature manifest migrate --to v1",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "manifest.migrate")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			to := config.GetMigrateTo(cmd)
+
+			files, err := manifest.ReadFiles(manifestPath)
+			if err != nil {
+				return err
+			}
+
+			// Migrate all files before writing any of them, so that a failure leaves the manifests untouched.
+			migrated := make([][]byte, len(files))
+			for i, file := range files {
+				migrated[i], err = manifest.Migrate(file.Data, to)
+				if err != nil {
+					return fmt.Errorf("error migrating %q: %w", file.Path, err)
+				}
+			}
+
+			updated := 0
+			for i, file := range files {
+				if bytes.Equal(migrated[i], file.Data) {
+					logger.Default.Debug(fmt.Sprintf("%s is already a %s manifest", file.Path, to))
+					continue
+				}
+				if err := filesystem.WriteFile(file.Path, migrated[i]); err != nil {
+					logger.Default.FileFailed(file.Path, err)
+					return err
+				}
+				logger.Default.FileUpdated(file.Path)
+				updated++
+			}
+
+			if updated == 0 {
+				logger.Default.Info(fmt.Sprintf("The manifest is already a %s manifest, no changes were made.", to))
+				return nil
+			}
+			logger.Default.Success(fmt.Sprintf("Migrated the manifest to %s.", to))
+			return nil
+		},
+	}
+
+	config.AddMigrateFlags(migrateCmd)
+
+	return migrateCmd
+}
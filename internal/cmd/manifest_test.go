@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+)
+
+func TestManifestMigrateCmd(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	files := map[string]string{
+		"flags.json":       `{"includes": ["teams/*.json"], "flags": {"a": {"flagType": "boolean", "defaultValue": true, "owner": "x"}}}`,
+		"teams/team.json":  `{"version": "v1", "flags": {"b": {"flagType": "string", "defaultValue": "b"}}}`,
+		"teams/other.json": `{"flags": {"c": {"flagType": "integer", "defaultValue": 1}}}`,
+	}
+	for path, content := range files {
+		if err := afero.WriteFile(fs, path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := GetManifestCmd()
+	config.AddRootFlags(cmd)
+	cmd.SetArgs([]string{"migrate", "-m", "flags.json", "--to", "v1"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	for path, content := range files {
+		data, err := afero.ReadFile(fs, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(data), `"version": "v1"`) {
+			t.Errorf("expected %s to be migrated to v1, got:\n%s", path, data)
+		}
+		if path == "teams/team.json" && string(data) != content {
+			t.Errorf("expected the v1 manifest %s to be unchanged, got:\n%s", path, data)
+		}
+	}
+}
@@ -63,6 +63,13 @@ func GetRootCmd() *cobra.Command {
 	rootCmd.AddCommand(GetInitCmd())
 	rootCmd.AddCommand(GetGenerateCmd())
 	rootCmd.AddCommand(GetCompareCmd())
+	rootCmd.AddCommand(GetManifestCmd())
+	rootCmd.AddCommand(GetLintCmd())
+	rootCmd.AddCommand(GetFormatCmd())
+	rootCmd.AddCommand(GetListCmd())
+	rootCmd.AddCommand(GetShowCmd())
+	rootCmd.AddCommand(GetUsageCmd())
+	rootCmd.AddCommand(GetCleanupCmd())
 
 	// Add a custom error handler after the command is created
 	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/open-feature/cli/internal/usage"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func GetUsageCmd() *cobra.Command {
+	usageCmd := &cobra.Command{
+		Use:   "usage [dir]",
+		Short: "Find the references to flags in source code",
+		Long: `Walk a source tree and find the references to the flags of the manifest.
+
+Both the generated accessors of every generator, e.g. EnableFeatureA, useEnableFeatureA or
+enable_feature_a, and evaluations of the OpenFeature SDKs with string literal flag keys, e.g.
+client.getBooleanValue("enableFeatureA", false), are found. The report lists the locations of
+each flag, the flags of the manifest that are never referenced, and the flag keys that are
+referenced but not defined in the manifest.
+
+Hidden directories, node_modules, vendor and files generated by the CLI are skipped.
+The generated React and NestJS files don't carry the generated header, exclude them with --exclude.`,
+		Example: `openfeature usage
+openfeature usage ./src --exclude "*_test.go" --exclude generated
+openfeature usage --output json`,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "usage")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat := config.GetOutputFormat(cmd)
+			exclude := config.GetExclude(cmd)
+			if !manifest.UsageOutputFormats.IsValid(outputFormat) {
+				return fmt.Errorf("invalid output format: %s. Valid formats are: %s",
+					outputFormat, strings.Join(manifest.UsageOutputFormats.Strings(), ", "))
+			}
+
+			dir := "."
+			if len(args) > 0 {
+				dir = args[0]
+			}
+
+			fs, err := flagset.Load(config.GetManifestPath(cmd), "")
+			if err != nil {
+				return err
+			}
+
+			scanner := usage.NewScanner(fs, generators.DefaultManager.GetAccessors(fs))
+			scanner.Exclude = exclude
+			report, err := scanner.Scan(dir)
+			if err != nil {
+				return fmt.Errorf("error scanning %q: %w", dir, err)
+			}
+
+			if manifest.OutputFormat(outputFormat) == manifest.OutputFormatJSON {
+				return renderStructured(cmd, manifest.OutputFormatJSON, report)
+			}
+			return renderUsageReport(report)
+		},
+	}
+
+	config.AddUsageFlags(usageCmd)
+
+	addStabilityInfo(usageCmd)
+
+	return usageCmd
+}
+
+// renderUsageReport renders the references as a table, followed by the unused and undeclared flags
+func renderUsageReport(report *usage.Report) error {
+	if len(report.Flags) > 0 {
+		if err := renderUsageTable(report.Flags); err != nil {
+			return err
+		}
+		pterm.Println()
+	}
+
+	if len(report.Unused) > 0 {
+		pterm.Warning.Printf("%d flag(s) of the manifest are not used: %s\n", len(report.Unused), strings.Join(report.Unused, ", "))
+	} else {
+		pterm.Success.Println("All flags of the manifest are used.")
+	}
+
+	if len(report.Undeclared) > 0 {
+		pterm.Println()
+		pterm.Warning.Printf("%d flag key(s) are used but not defined in the manifest:\n", len(report.Undeclared))
+		return renderUsageTable(report.Undeclared)
+	}
+	return nil
+}
+
+func renderUsageTable(flags []usage.FlagUsage) error {
+	tableData := [][]string{
+		{"Flag", "Location", "Kind"},
+	}
+	for _, flag := range flags {
+		for i, location := range flag.Locations {
+			key := ""
+			if i == 0 {
+				key = flag.Key
+			}
+			tableData = append(tableData, []string{key, location.String(), string(location.Kind)})
+		}
+	}
+	return pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+}
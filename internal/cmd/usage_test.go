@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/usage"
+	"github.com/spf13/afero"
+)
+
+func TestUsageCmd(t *testing.T) {
+	setupListTest(t)
+	source := `package main
+
+func main() {
+	v, _ := flags.EnableFeatureA.Value(ctx, evalCtx)
+	s, _ := client.StringValue(ctx, "legacyKey", "", evalCtx)
+}
+`
+	if err := afero.WriteFile(filesystem.FileSystem(), "src/main.go", []byte(source), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := GetUsageCmd()
+	config.AddRootFlags(cmd)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs([]string{"src", "-m", "flags.json", "-o", "json"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	var report usage.Report
+	if err := json.Unmarshal(out.Bytes(), &report); err != nil {
+		t.Fatalf("error unmarshaling output %q: %v", out.String(), err)
+	}
+	if len(report.Flags) != 1 || report.Flags[0].Key != "enableFeatureA" {
+		t.Errorf("expected only enableFeatureA to be used, got %v", report.Flags)
+	}
+	if len(report.Unused) != 2 {
+		t.Errorf("expected 2 unused flags, got %v", report.Unused)
+	}
+	if len(report.Undeclared) != 1 || report.Undeclared[0].Key != "legacyKey" {
+		t.Errorf("expected legacyKey to be undeclared, got %v", report.Undeclared)
+	}
+}
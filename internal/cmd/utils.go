@@ -1,6 +1,14 @@
 package cmd
 
-import "github.com/pterm/pterm"
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
 
 func printBanner() {
 	ivrit := `
@@ -18,3 +26,22 @@ func printBanner() {
 	pterm.Printf("version: %s | compiled: %s\n", pterm.LightGreen(Version), pterm.LightGreen(Date))
 	pterm.Println(pterm.Cyan("🔗 https://openfeature.dev | https://github.com/open-feature/cli"))
 }
+
+// renderStructured writes the value to the output of the command as JSON or YAML
+func renderStructured(cmd *cobra.Command, format manifest.OutputFormat, value any) error {
+	if format == manifest.OutputFormatYAML {
+		data, err := yaml.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("error marshaling YAML output: %w", err)
+		}
+		_, err = cmd.OutOrStdout().Write(data)
+		return err
+	}
+
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling JSON output: %w", err)
+	}
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(data))
+	return err
+}
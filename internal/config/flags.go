@@ -1,19 +1,42 @@
 package config
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/open-feature/cli/internal/manifest"
 	"github.com/spf13/cobra"
 )
 
 // Flag name constants to avoid duplication
 const (
-	DebugFlagName       = "debug"
-	ManifestFlagName    = "manifest"
-	OutputFlagName      = "output"
-	NoInputFlagName     = "no-input"
-	GoPackageFlagName   = "package-name"
-	CSharpNamespaceName = "namespace"
-	OverrideFlagName    = "override"
-	JavaPackageFlagName = "package-name"
+	DebugFlagName             = "debug"
+	ManifestFlagName          = "manifest"
+	OutputFlagName            = "output"
+	NoInputFlagName           = "no-input"
+	GoPackageFlagName         = "package-name"
+	CSharpNamespaceName       = "namespace"
+	OverrideFlagName          = "override"
+	JavaPackageFlagName       = "package-name"
+	PHPNamespaceName          = "php-namespace"
+	RubyModuleFlagName        = "module-name"
+	DartFlutterFlagName       = "flutter"
+	TestHelpersFlagName       = "test-helpers"
+	JavaSpringFlagName        = "spring"
+	CSharpLoggingHookFlagName = "logging-hook"
+	PythonModuleFlagName      = "python-module"
+	PythonPackageFlagName     = "package"
+	PythonAPIFlagName         = "api"
+	EnvironmentFlagName       = "env"
+	MigrateToFlagName         = "to"
+	FormatCheckFlagName       = "check"
+	FormatIndentFlagName      = "indent"
+	FormatSortKeysFlagName    = "sort-keys"
+	InitFromSourceFlagName    = "from-source"
+	ExcludeFlagName           = "exclude"
+	CleanupValueFlagName      = "value"
+	CleanupSourceFlagName     = "source"
+	CleanupRegenerateFlagName = "regenerate"
 )
 
 // Default values for flags
@@ -23,8 +46,17 @@ const (
 	DefaultGoPackageName   = "openfeature"
 	DefaultCSharpNamespace = "OpenFeature"
 	DefaultJavaPackageName = "com.example.openfeature"
+	DefaultPHPNamespace    = "App\\OpenFeature"
+	DefaultRubyModuleName  = "OpenFeatureFlags"
+	DefaultPythonModule    = "openfeature_flags"
+	DefaultPythonAPI       = "both"
+	DefaultFormatIndent    = 2
+	DefaultCleanupSource   = "."
 )
 
+const environmentFlagUsage = "Environment whose overlay overrides the default values of the manifest, e.g. production. " +
+	"The overlay is read from the environments of the manifest or from the sidecar file <manifest>.<env>.json"
+
 // AddRootFlags adds the common flags to the given command
 func AddRootFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringP(ManifestFlagName, "m", DefaultManifestPath, "Path to the flag manifest")
@@ -35,6 +67,12 @@ func AddRootFlags(cmd *cobra.Command) {
 // AddGenerateFlags adds the common generate flags to the given command
 func AddGenerateFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringP(OutputFlagName, "o", DefaultOutputPath, "Path to where the generated files should be saved")
+	cmd.PersistentFlags().String(EnvironmentFlagName, "", environmentFlagUsage)
+}
+
+// AddCompareFlags adds the compare specific flags to the given command
+func AddCompareFlags(cmd *cobra.Command) {
+	cmd.Flags().String(EnvironmentFlagName, "", environmentFlagUsage)
 }
 
 // AddGoGenerateFlags adds the go generator specific flags to the given command
@@ -45,16 +83,76 @@ func AddGoGenerateFlags(cmd *cobra.Command) {
 // AddCSharpGenerateFlags adds the C# generator specific flags to the given command
 func AddCSharpGenerateFlags(cmd *cobra.Command) {
 	cmd.Flags().String(CSharpNamespaceName, DefaultCSharpNamespace, "Namespace for the generated C# code")
+	cmd.Flags().Bool(CSharpLoggingHookFlagName, false, "Generate an evaluation logging hook based on Microsoft.Extensions.Logging")
 }
 
 // AddJavaGenerateFlags adds the Java generator specific flags to the given command
 func AddJavaGenerateFlags(cmd *cobra.Command) {
 	cmd.Flags().String(JavaPackageFlagName, DefaultJavaPackageName, "Name of the generated Java package")
+	cmd.Flags().Bool(JavaSpringFlagName, false, "Generate a Spring configuration, a conditional annotation for boolean flags and a flag key enum")
+}
+
+// AddPythonGenerateFlags adds the Python generator specific flags to the given command
+func AddPythonGenerateFlags(cmd *cobra.Command) {
+	cmd.Flags().String(PythonModuleFlagName, DefaultPythonModule, "Name of the generated Python module or package")
+	cmd.Flags().Bool(PythonPackageFlagName, false, "Generate a package with a type stub and a py.typed marker")
+	cmd.Flags().String(PythonAPIFlagName, DefaultPythonAPI, "Accessors to generate: both, sync or async")
+}
+
+// AddPHPGenerateFlags adds the PHP generator specific flags to the given command
+func AddPHPGenerateFlags(cmd *cobra.Command) {
+	cmd.Flags().String(PHPNamespaceName, DefaultPHPNamespace, "Namespace for the generated PHP class")
+}
+
+// AddRubyGenerateFlags adds the Ruby generator specific flags to the given command
+func AddRubyGenerateFlags(cmd *cobra.Command) {
+	cmd.Flags().String(RubyModuleFlagName, DefaultRubyModuleName, "Name of the generated Ruby module")
+}
+
+// AddDartGenerateFlags adds the Dart generator specific flags to the given command
+func AddDartGenerateFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(DartFlutterFlagName, false, "Generate Flutter widgets that rebuild on provider events, built on the Dart server SDK")
+}
+
+// AddTestHelperFlags adds the flag for generating test helpers to the given command
+func AddTestHelperFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(TestHelpersFlagName, false, "Generate test helpers backed by an in-memory provider")
 }
 
 // AddInitFlags adds the init command specific flags
 func AddInitFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool(OverrideFlagName, false, "Override an existing configuration")
+	cmd.Flags().String(InitFromSourceFlagName, "", "Directory whose OpenFeature SDK calls the draft manifest is inferred from")
+}
+
+// AddMigrateFlags adds the manifest migrate command specific flags
+func AddMigrateFlags(cmd *cobra.Command) {
+	cmd.Flags().String(MigrateToFlagName, manifest.LatestVersion, "Schema version to migrate the manifest to")
+}
+
+// AddFormatFlags adds the fmt command specific flags
+func AddFormatFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(FormatCheckFlagName, false, "Report the files that are not formatted instead of rewriting them")
+	cmd.Flags().Int(FormatIndentFlagName, DefaultFormatIndent, "Number of spaces to indent by")
+	cmd.Flags().Bool(FormatSortKeysFlagName, false, "Sort flags and free-form objects by key instead of preserving their order")
+}
+
+// AddUsageFlags adds the usage command specific flags
+func AddUsageFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(OutputFlagName, "o", string(manifest.OutputFormatTable),
+		fmt.Sprintf("Output format. Valid formats: %s", strings.Join(manifest.UsageOutputFormats.Strings(), ", ")))
+	cmd.Flags().StringSlice(ExcludeFlagName, nil,
+		"Glob pattern of paths that are not scanned. Patterns without a slash match file and directory names")
+}
+
+// AddCleanupFlags adds the cleanup command specific flags
+func AddCleanupFlags(cmd *cobra.Command) {
+	cmd.Flags().String(CleanupValueFlagName, "", "Final value of the flag as JSON, e.g. true. Defaults to the default value of the flag")
+	cmd.Flags().String(CleanupSourceFlagName, DefaultCleanupSource, "Directory of the Go code to rewrite")
+	cmd.Flags().StringSlice(ExcludeFlagName, nil,
+		"Glob pattern of paths that are not rewritten. Patterns without a slash match file and directory names")
+	cmd.Flags().StringSlice(CleanupRegenerateFlagName, nil,
+		"Generators to run after removing the flag. Defaults to the generators configured in the generate section of the config file")
 }
 
 // GetManifestPath gets the manifest path from the given command
@@ -63,12 +161,67 @@ func GetManifestPath(cmd *cobra.Command) string {
 	return manifestPath
 }
 
+// GetEnvironment gets the environment of the overlay from the given command
+func GetEnvironment(cmd *cobra.Command) string {
+	env, _ := cmd.Flags().GetString(EnvironmentFlagName)
+	return env
+}
+
+// GetMigrateTo gets the schema version to migrate the manifest to from the given command
+func GetMigrateTo(cmd *cobra.Command) string {
+	version, _ := cmd.Flags().GetString(MigrateToFlagName)
+	return version
+}
+
+// GetFormatOptions gets the canonical form of manifests from the given command
+func GetFormatOptions(cmd *cobra.Command) manifest.FormatOptions {
+	indent, _ := cmd.Flags().GetInt(FormatIndentFlagName)
+	sortKeys, _ := cmd.Flags().GetBool(FormatSortKeysFlagName)
+	return manifest.FormatOptions{Indent: indent, SortKeys: sortKeys}
+}
+
+// GetFormatCheck gets whether the fmt command only checks the formatting from the given command
+func GetFormatCheck(cmd *cobra.Command) bool {
+	check, _ := cmd.Flags().GetBool(FormatCheckFlagName)
+	return check
+}
+
 // GetOutputPath gets the output path from the given command
 func GetOutputPath(cmd *cobra.Command) string {
 	outputPath, _ := cmd.Flags().GetString(OutputFlagName)
 	return outputPath
 }
 
+// GetOutputFormat gets the output format from the given command
+func GetOutputFormat(cmd *cobra.Command) string {
+	outputFormat, _ := cmd.Flags().GetString(OutputFlagName)
+	return outputFormat
+}
+
+// GetExclude gets the glob patterns of the excluded paths from the given command
+func GetExclude(cmd *cobra.Command) []string {
+	exclude, _ := cmd.Flags().GetStringSlice(ExcludeFlagName)
+	return exclude
+}
+
+// GetCleanupValue gets the final value of the removed flag as JSON from the given command
+func GetCleanupValue(cmd *cobra.Command) string {
+	value, _ := cmd.Flags().GetString(CleanupValueFlagName)
+	return value
+}
+
+// GetCleanupSource gets the directory of the code to rewrite from the given command
+func GetCleanupSource(cmd *cobra.Command) string {
+	source, _ := cmd.Flags().GetString(CleanupSourceFlagName)
+	return source
+}
+
+// GetCleanupRegenerate gets the generators to run after the cleanup from the given command
+func GetCleanupRegenerate(cmd *cobra.Command) []string {
+	generators, _ := cmd.Flags().GetStringSlice(CleanupRegenerateFlagName)
+	return generators
+}
+
 // GetGoPackageName gets the Go package name from the given command
 func GetGoPackageName(cmd *cobra.Command) string {
 	goPackageName, _ := cmd.Flags().GetString(GoPackageFlagName)
@@ -81,12 +234,66 @@ func GetCSharpNamespace(cmd *cobra.Command) string {
 	return namespace
 }
 
+// GetCSharpLoggingHook gets the logging-hook flag from the given command
+func GetCSharpLoggingHook(cmd *cobra.Command) bool {
+	loggingHook, _ := cmd.Flags().GetBool(CSharpLoggingHookFlagName)
+	return loggingHook
+}
+
 // GetJavaPackageName gets the Java package name from the given command
 func GetJavaPackageName(cmd *cobra.Command) string {
 	javaPackageName, _ := cmd.Flags().GetString(JavaPackageFlagName)
 	return javaPackageName
 }
 
+// GetJavaSpring gets the spring flag from the given command
+func GetJavaSpring(cmd *cobra.Command) bool {
+	spring, _ := cmd.Flags().GetBool(JavaSpringFlagName)
+	return spring
+}
+
+// GetPythonModuleName gets the Python module name from the given command
+func GetPythonModuleName(cmd *cobra.Command) string {
+	moduleName, _ := cmd.Flags().GetString(PythonModuleFlagName)
+	return moduleName
+}
+
+// GetPythonPackage gets the package flag from the given command
+func GetPythonPackage(cmd *cobra.Command) bool {
+	pkg, _ := cmd.Flags().GetBool(PythonPackageFlagName)
+	return pkg
+}
+
+// GetPythonAPI gets the Python API flavor from the given command
+func GetPythonAPI(cmd *cobra.Command) string {
+	api, _ := cmd.Flags().GetString(PythonAPIFlagName)
+	return api
+}
+
+// GetPHPNamespace gets the PHP namespace from the given command
+func GetPHPNamespace(cmd *cobra.Command) string {
+	namespace, _ := cmd.Flags().GetString(PHPNamespaceName)
+	return namespace
+}
+
+// GetRubyModuleName gets the Ruby module name from the given command
+func GetRubyModuleName(cmd *cobra.Command) string {
+	moduleName, _ := cmd.Flags().GetString(RubyModuleFlagName)
+	return moduleName
+}
+
+// GetDartFlutter gets the flutter flag from the given command
+func GetDartFlutter(cmd *cobra.Command) bool {
+	flutter, _ := cmd.Flags().GetBool(DartFlutterFlagName)
+	return flutter
+}
+
+// GetTestHelpers gets the test-helpers flag from the given command
+func GetTestHelpers(cmd *cobra.Command) bool {
+	testHelpers, _ := cmd.Flags().GetBool(TestHelpersFlagName)
+	return testHelpers
+}
+
 // GetNoInput gets the no-input flag from the given command
 func GetNoInput(cmd *cobra.Command) bool {
 	noInput, _ := cmd.Flags().GetBool(NoInputFlagName)
@@ -98,3 +305,9 @@ func GetOverride(cmd *cobra.Command) bool {
 	override, _ := cmd.Flags().GetBool(OverrideFlagName)
 	return override
 }
+
+// GetInitFromSource gets the source directory of the init command from the given command
+func GetInitFromSource(cmd *cobra.Command) string {
+	dir, _ := cmd.Flags().GetString(InitFromSourceFlagName)
+	return dir
+}
@@ -7,9 +7,7 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/open-feature/cli/internal/filesystem"
 	"github.com/open-feature/cli/internal/manifest"
-	"github.com/spf13/afero"
 )
 
 // FlagType are the primitive types of flags.
@@ -42,43 +40,143 @@ func (f FlagType) String() string {
 	}
 }
 
+// ManifestType returns the type as written in the manifest, e.g. "boolean".
+func (f FlagType) ManifestType() string {
+	switch f {
+	case IntType:
+		return "integer"
+	case FloatType:
+		return "float"
+	case BoolType:
+		return "boolean"
+	case StringType:
+		return "string"
+	case ObjectType:
+		return "object"
+	default:
+		return "unknown"
+	}
+}
+
 type Flag struct {
 	Key          string
 	Type         FlagType
 	Description  string
 	DefaultValue any
+	// Group is the namespace the flag is generated in, empty for top-level flags.
+	Group string
+	// Source is the manifest file the flag is defined in, set for manifests that include other manifests.
+	Source string
+	// Metadata are the custom properties of the flag, see manifest.Metadata. It is nil for flags without any.
+	Metadata map[string]any
+}
+
+// Name returns the key of the flag relative to its group, e.g. "newFlow" for the
+// key "checkout.newFlow" in the group "checkout". Flags without a group are named by their key.
+func (f Flag) Name() string {
+	if f.Group == "" {
+		return f.Key
+	}
+	if name, ok := strings.CutPrefix(f.Key, f.Group+"."); ok && name != "" {
+		return name
+	}
+	return f.Key
+}
+
+// FlagGroup is a namespace of flags sharing the same group.
+type FlagGroup struct {
+	Name  string
+	Flags []Flag
+}
+
+// ContextAttribute is an evaluation context attribute that flags depend on.
+type ContextAttribute struct {
+	Name        string
+	Type        FlagType
+	Required    bool
+	Description string
 }
 
 type Flagset struct {
 	Flags []Flag
+	// Context are the evaluation context attributes declared in the manifest, sorted by name.
+	Context []ContextAttribute
 }
 
-// Loads, validates, and unmarshals the manifest file at the given path into a flagset
-func Load(manifestPath string) (*Flagset, error) {
-	fs := filesystem.FileSystem()
-	data, err := afero.ReadFile(fs, manifestPath)
+// Loads, validates, and unmarshals the manifest file at the given path into a flagset.
+// The flags of included manifests are merged into the flagset. If env is not empty,
+// the default values are overridden by the overlay of that environment.
+func Load(manifestPath, env string) (*Flagset, error) {
+	files, err := manifest.ReadFiles(manifestPath)
 	if err != nil {
-		return nil, fmt.Errorf("error reading contents from file %q", manifestPath)
+		return nil, err
 	}
-
-	validationErrors, err := manifest.Validate(data)
+	files, err = manifest.ApplyEnvironment(files, env)
 	if err != nil {
 		return nil, err
-	} else if len(validationErrors) > 0 {
-		return nil, errors.New(FormatValidationError(validationErrors))
+	}
+
+	var issues []manifest.ValidationError
+	for _, file := range files {
+		validationErrors, err := manifest.Validate(file.Data)
+		if err != nil {
+			return nil, err
+		}
+		if len(files) > 1 {
+			for i := range validationErrors {
+				validationErrors[i].File = file.Path
+			}
+		}
+		issues = append(issues, validationErrors...)
+	}
+	if len(issues) > 0 {
+		return nil, errors.New(FormatValidationError(issues))
 	}
 
 	var flagset Flagset
-	if err := json.Unmarshal(data, &flagset); err != nil {
-		return nil, fmt.Errorf("error unmarshaling JSON: %v", validationErrors)
+	sources := make(map[string]string)
+	attributes := make(map[string]ContextAttribute)
+	for _, file := range files {
+		var included Flagset
+		if err := json.Unmarshal(file.Data, &included); err != nil {
+			return nil, fmt.Errorf("error unmarshaling JSON from file %q: %v", file.Path, err)
+		}
+		for _, attribute := range included.Context {
+			if other, ok := attributes[attribute.Name]; ok {
+				if other != attribute {
+					return nil, fmt.Errorf("context attribute %q is declared differently in file %q", attribute.Name, file.Path)
+				}
+				continue
+			}
+			attributes[attribute.Name] = attribute
+			flagset.Context = append(flagset.Context, attribute)
+		}
+		for _, flag := range included.Flags {
+			if source, ok := sources[flag.Key]; ok {
+				return nil, &manifest.DuplicateKeyError{Key: flag.Key, First: source, Second: file.Path}
+			}
+			sources[flag.Key] = file.Path
+			if len(files) > 1 {
+				flag.Source = file.Path
+			}
+			flagset.Flags = append(flagset.Flags, flag)
+		}
 	}
 
+	// Ensure consistency of order of flag generation.
+	sort.Slice(flagset.Flags, func(i, j int) bool {
+		return flagset.Flags[i].Key < flagset.Flags[j].Key
+	})
+	sort.Slice(flagset.Context, func(i, j int) bool {
+		return flagset.Context[i].Name < flagset.Context[j].Name
+	})
+
 	return &flagset, nil
 }
 
 // Filter removes flags from the Flagset that are of unsupported types.
 func (fs *Flagset) Filter(unsupportedFlagTypes map[FlagType]bool) *Flagset {
-	var filtered Flagset
+	filtered := Flagset{Context: fs.Context}
 	for _, flag := range fs.Flags {
 		if !unsupportedFlagTypes[flag.Type] {
 			filtered.Flags = append(filtered.Flags, flag)
@@ -87,34 +185,68 @@ func (fs *Flagset) Filter(unsupportedFlagTypes map[FlagType]bool) *Flagset {
 	return &filtered
 }
 
+// Ungrouped returns the flags that don't belong to a group.
+func (fs *Flagset) Ungrouped() []Flag {
+	var flags []Flag
+	for _, flag := range fs.Flags {
+		if flag.Group == "" {
+			flags = append(flags, flag)
+		}
+	}
+	return flags
+}
+
+// Groups returns the flags that belong to a group, grouped and sorted by the name of the group.
+func (fs *Flagset) Groups() []FlagGroup {
+	var groups []FlagGroup
+	index := make(map[string]int)
+	for _, flag := range fs.Flags {
+		if flag.Group == "" {
+			continue
+		}
+		i, ok := index[flag.Group]
+		if !ok {
+			i = len(groups)
+			index[flag.Group] = i
+			groups = append(groups, FlagGroup{Name: flag.Group})
+		}
+		groups[i].Flags = append(groups[i].Flags, flag)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Name < groups[j].Name
+	})
+	return groups
+}
+
 // UnmarshalJSON unmarshals the JSON data into a Flagset. It is used by json.Unmarshal.
 func (fs *Flagset) UnmarshalJSON(data []byte) error {
-	var manifest struct {
+	var m struct {
 		Flags map[string]struct {
 			FlagType     string `json:"flagType"`
 			Description  string `json:"description"`
 			DefaultValue any    `json:"defaultValue"`
+			Group        string `json:"group"`
 		} `json:"flags"`
+		Context map[string]struct {
+			Type        string `json:"type"`
+			Required    bool   `json:"required"`
+			Description string `json:"description"`
+		} `json:"context"`
 	}
 
-	if err := json.Unmarshal(data, &manifest); err != nil {
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	var raw struct {
+		Flags map[string]any `json:"flags"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
 		return err
 	}
 
-	for key, flag := range manifest.Flags {
-		var flagType FlagType
-		switch flag.FlagType {
-		case "integer":
-			flagType = IntType
-		case "float":
-			flagType = FloatType
-		case "boolean":
-			flagType = BoolType
-		case "string":
-			flagType = StringType
-		case "object":
-			flagType = ObjectType
-		default:
+	for key, flag := range m.Flags {
+		flagType := ParseFlagType(flag.FlagType)
+		if flagType == UnknownFlagType {
 			return errors.New("unknown flag type")
 		}
 
@@ -123,6 +255,24 @@ func (fs *Flagset) UnmarshalJSON(data []byte) error {
 			Type:         flagType,
 			Description:  flag.Description,
 			DefaultValue: flag.DefaultValue,
+			Group:        flag.Group,
+			Metadata:     metadata(raw.Flags[key]),
+		})
+	}
+
+	for name, attribute := range m.Context {
+		attributeType := ParseFlagType(attribute.Type)
+		if attributeType == UnknownFlagType || attributeType == ObjectType {
+			return fmt.Errorf("unknown type %q of context attribute %q", attribute.Type, name)
+		}
+		if name == "targetingKey" {
+			return errors.New("context attribute \"targetingKey\" must not be declared, it is part of every evaluation context")
+		}
+		fs.Context = append(fs.Context, ContextAttribute{
+			Name:        name,
+			Type:        attributeType,
+			Required:    attribute.Required,
+			Description: attribute.Description,
 		})
 	}
 
@@ -130,44 +280,84 @@ func (fs *Flagset) UnmarshalJSON(data []byte) error {
 	sort.Slice(fs.Flags, func(i, j int) bool {
 		return fs.Flags[i].Key < fs.Flags[j].Key
 	})
+	sort.Slice(fs.Context, func(i, j int) bool {
+		return fs.Context[i].Name < fs.Context[j].Name
+	})
 
 	return nil
 }
+
+// metadata returns the custom properties of the raw flag, or nil if it has none.
+func metadata(flag any) map[string]any {
+	metadata := manifest.Metadata(flag)
+	if len(metadata) == 0 {
+		return nil
+	}
+	return metadata
+}
+
+// ParseFlagType parses the type of a flag as written in the manifest.
+func ParseFlagType(s string) FlagType {
+	switch s {
+	case "integer":
+		return IntType
+	case "float":
+		return FloatType
+	case "boolean":
+		return BoolType
+	case "string":
+		return StringType
+	case "object":
+		return ObjectType
+	default:
+		return UnknownFlagType
+	}
+}
 func FormatValidationError(issues []manifest.ValidationError) string {
 	var sb strings.Builder
 	sb.WriteString("flag manifest validation failed:\n\n")
 
-	// Group messages by flag path
+	// Group messages by file and flag path
 	grouped := make(map[string]struct {
+		file     string
+		path     string
 		flagType string
 		messages []string
 	})
 
 	for _, issue := range issues {
-		entry := grouped[issue.Path]
+		key := issue.File + "\x00" + issue.Path
+		entry := grouped[key]
+		entry.file = issue.File
+		entry.path = issue.Path
 		entry.flagType = issue.Type
 		entry.messages = append(entry.messages, issue.Message)
-		grouped[issue.Path] = entry
+		grouped[key] = entry
 	}
 
 	// Sort paths for consistent output
-	paths := make([]string, 0, len(grouped))
-	for path := range grouped {
-		paths = append(paths, path)
+	keys := make([]string, 0, len(grouped))
+	for key := range grouped {
+		keys = append(keys, key)
 	}
-	sort.Strings(paths)
+	sort.Strings(keys)
 
 	// Format each row
-	for _, path := range paths {
-		entry := grouped[path]
+	for _, key := range keys {
+		entry := grouped[key]
 		flagType := entry.flagType
 		if flagType == "" {
 			flagType = "missing"
 		}
+		if entry.file != "" {
+			sb.WriteString(fmt.Sprintf("- file: %s\n  ", entry.file))
+		} else {
+			sb.WriteString("- ")
+		}
 		sb.WriteString(fmt.Sprintf(
-			"- flagType: %s\n  flagPath: %s\n  errors:\n    ~ %s\n  \tSuggestions:\n      \t- flagType: boolean\n      \t- defaultValue: true\n\n",
+			"flagType: %s\n  flagPath: %s\n  errors:\n    ~ %s\n  \tSuggestions:\n      \t- flagType: boolean\n      \t- defaultValue: true\n\n",
 			flagType,
-			path,
+			entry.path,
 			strings.Join(entry.messages, "\n    ~ "),
 		))
 	}
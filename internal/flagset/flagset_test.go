@@ -4,7 +4,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/open-feature/cli/internal/filesystem"
 	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/afero"
 )
 
 // Sample test for FormatValidationError
@@ -27,3 +29,125 @@ func TestFormatValidationError_SortsByPath(t *testing.T) {
 			alphaIdx, betaIdx, zetaIdx, output)
 	}
 }
+
+func TestGroups(t *testing.T) {
+	var fs Flagset
+	data := `{"flags": {
+		"checkout.newFlow": {"flagType": "boolean", "defaultValue": false, "group": "checkout"},
+		"checkout.expressPay": {"flagType": "boolean", "defaultValue": true, "group": "checkout"},
+		"searchLimit": {"flagType": "integer", "defaultValue": 10, "group": "search"},
+		"themeColor": {"flagType": "string", "defaultValue": "blue"}
+	}}`
+	if err := fs.UnmarshalJSON([]byte(data)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ungrouped := fs.Ungrouped()
+	if len(ungrouped) != 1 || ungrouped[0].Key != "themeColor" {
+		t.Errorf("unexpected ungrouped flags: %+v", ungrouped)
+	}
+
+	groups := fs.Groups()
+	if len(groups) != 2 {
+		t.Fatalf("expected 2 groups, got %d", len(groups))
+	}
+	if groups[0].Name != "checkout" || groups[1].Name != "search" {
+		t.Errorf("groups are not sorted by name: %q, %q", groups[0].Name, groups[1].Name)
+	}
+
+	var names []string
+	for _, flag := range groups[0].Flags {
+		names = append(names, flag.Name())
+	}
+	if strings.Join(names, ",") != "expressPay,newFlow" {
+		t.Errorf("unexpected flag names in group checkout: %v", names)
+	}
+	if name := groups[1].Flags[0].Name(); name != "searchLimit" {
+		t.Errorf("expected name of a flag without the group prefix to be its key, got %q", name)
+	}
+}
+
+func TestLoadIncludes(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	files := map[string]string{
+		"flags.json":          `{"includes": ["teams/*.json"], "flags": {"themeColor": {"flagType": "string", "defaultValue": "blue"}}}`,
+		"teams/checkout.json": `{"flags": {"newFlow": {"flagType": "boolean", "defaultValue": false}}}`,
+	}
+	for path, content := range files {
+		if err := afero.WriteFile(fs, path, []byte(content), 0o644); err != nil {
+			t.Fatalf("error writing file %q: %v", path, err)
+		}
+	}
+
+	fset, err := Load("flags.json", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fset.Flags) != 2 {
+		t.Fatalf("expected 2 flags, got %d", len(fset.Flags))
+	}
+	if fset.Flags[0].Key != "newFlow" || fset.Flags[0].Source != "teams/checkout.json" {
+		t.Errorf("unexpected flag: %+v", fset.Flags[0])
+	}
+	if fset.Flags[1].Key != "themeColor" || fset.Flags[1].Source != "flags.json" {
+		t.Errorf("unexpected flag: %+v", fset.Flags[1])
+	}
+}
+
+func TestLoadIncludesReportsFile(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	files := map[string]string{
+		"flags.json":          `{"includes": ["teams/*.json"], "flags": {"newFlow": {"flagType": "boolean", "defaultValue": true}}}`,
+		"teams/checkout.json": `{"flags": {"newFlow": {"flagType": "boolean", "defaultValue": false}}}`,
+		"teams/search.json":   `{"flags": {"provider": {"flagType": "unknown", "defaultValue": "elastic"}}}`,
+	}
+	for path, content := range files {
+		if err := afero.WriteFile(fs, path, []byte(content), 0o644); err != nil {
+			t.Fatalf("error writing file %q: %v", path, err)
+		}
+	}
+
+	_, err := Load("flags.json", "")
+	if err == nil || !strings.Contains(err.Error(), "file: teams/search.json") {
+		t.Fatalf("expected the validation error to name the file, got %v", err)
+	}
+
+	if err := afero.WriteFile(fs, "teams/search.json", []byte(`{"flags": {}}`), 0o644); err != nil {
+		t.Fatalf("error writing file: %v", err)
+	}
+	_, err = Load("flags.json", "")
+	if err == nil || !strings.Contains(err.Error(), `flag "newFlow" is defined in both "flags.json" and "teams/checkout.json"`) {
+		t.Fatalf("expected a duplicate key error, got %v", err)
+	}
+}
+
+func TestContext(t *testing.T) {
+	var fs Flagset
+	data := `{"flags": {}, "context": {
+		"plan": {"type": "string", "required": true, "description": "The plan of the user."},
+		"age": {"type": "integer"}
+	}}`
+	if err := fs.UnmarshalJSON([]byte(data)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []ContextAttribute{
+		{Name: "age", Type: IntType},
+		{Name: "plan", Type: StringType, Required: true, Description: "The plan of the user."},
+	}
+	if len(fs.Context) != len(expected) {
+		t.Fatalf("expected %d context attributes, got %d", len(expected), len(fs.Context))
+	}
+	for i := range expected {
+		if fs.Context[i] != expected[i] {
+			t.Errorf("expected %+v, got %+v", expected[i], fs.Context[i])
+		}
+	}
+
+	var invalid Flagset
+	if err := invalid.UnmarshalJSON([]byte(`{"flags": {}, "context": {"targetingKey": {"type": "string"}}}`)); err == nil {
+		t.Error("expected an error for a declared targetingKey")
+	}
+}
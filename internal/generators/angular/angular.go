@@ -0,0 +1,53 @@
+package angular
+
+import (
+	_ "embed"
+	"text/template"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+)
+
+type AngularGenerator struct {
+	generators.CommonGenerator
+}
+
+type Params struct {
+}
+
+// reservedWords include the constructor and the injected fields of the generated service,
+// which a flag accessor must not shadow.
+var reservedWords = generators.JavaScriptReservedWords.With("constructor", "featureFlagService", "injector")
+
+//go:embed angular.tmpl
+var angularTmpl string
+
+func (g *AngularGenerator) Generate(params *generators.Params[Params]) error {
+	funcs := template.FuncMap{
+		"OpenFeatureType":    generators.TypeScriptType,
+		"FormatDefaultValue": generators.DefaultValueFormatter(generators.QuoteJavaScript),
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath: params.OutputPath,
+		Custom:     Params{},
+	}
+
+	return g.GenerateFile(funcs, angularTmpl, newParams, "openfeature.ts")
+}
+
+// NewGenerator creates a generator for Angular.
+func NewGenerator(fs *flagset.Flagset) *AngularGenerator {
+	return &AngularGenerator{
+		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{
+			flagset.ObjectType: true,
+		}, reservedWords),
+	}
+}
+
+// Accessors returns the names of the generated accessors of the flags, e.g. enableFeatureA.
+func Accessors(fs *flagset.Flagset) map[string]string {
+	return NewGenerator(fs).Accessors(func(flag flagset.Flag, id generators.Identifiers) string {
+		return id.Camel(flag.Key)
+	})
+}
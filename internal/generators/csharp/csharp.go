@@ -2,7 +2,6 @@ package csharp
 
 import (
 	_ "embed"
-	"fmt"
 	"text/template"
 
 	"github.com/open-feature/cli/internal/flagset"
@@ -15,12 +14,21 @@ type CsharpGenerator struct {
 
 type Params struct {
 	// Add C# specific parameters here if needed
-	Namespace string
+	Namespace   string
+	TestHelpers bool
+	// LoggingHook enables the generation of an evaluation logging hook based on Microsoft.Extensions.Logging
+	LoggingHook bool
 }
 
 //go:embed csharp.tmpl
 var csharpTmpl string
 
+//go:embed csharp.testing.tmpl
+var csharpTestingTmpl string
+
+//go:embed csharp.logging.tmpl
+var csharpLoggingTmpl string
+
 func openFeatureType(t flagset.FlagType) string {
 	switch t {
 	case flagset.IntType:
@@ -36,24 +44,10 @@ func openFeatureType(t flagset.FlagType) string {
 	}
 }
 
-func formatDefaultValue(flag flagset.Flag) string {
-	switch flag.Type {
-	case flagset.StringType:
-		return fmt.Sprintf("\"%s\"", flag.DefaultValue)
-	case flagset.BoolType:
-		if flag.DefaultValue == true {
-			return "true"
-		}
-		return "false"
-	default:
-		return fmt.Sprintf("%v", flag.DefaultValue)
-	}
-}
-
 func (g *CsharpGenerator) Generate(params *generators.Params[Params]) error {
 	funcs := template.FuncMap{
 		"OpenFeatureType":    openFeatureType,
-		"FormatDefaultValue": formatDefaultValue,
+		"FormatDefaultValue": generators.DefaultValueFormatter(generators.QuoteCSharp),
 	}
 
 	newParams := &generators.Params[any]{
@@ -61,7 +55,21 @@ func (g *CsharpGenerator) Generate(params *generators.Params[Params]) error {
 		Custom:     params.Custom,
 	}
 
-	return g.GenerateFile(funcs, csharpTmpl, newParams, "OpenFeature.g.cs")
+	if err := g.GenerateFile(funcs, csharpTmpl, newParams, "OpenFeature.g.cs"); err != nil {
+		return err
+	}
+
+	if params.Custom.LoggingHook {
+		if err := g.GenerateFile(funcs, csharpLoggingTmpl, newParams, "OpenFeatureLoggingHook.g.cs"); err != nil {
+			return err
+		}
+	}
+
+	if !params.Custom.TestHelpers {
+		return nil
+	}
+
+	return g.GenerateFile(funcs, csharpTestingTmpl, newParams, "OpenFeatureTestFlags.g.cs")
 }
 
 // NewGenerator creates a generator for C#.
@@ -69,6 +77,13 @@ func NewGenerator(fs *flagset.Flagset) *CsharpGenerator {
 	return &CsharpGenerator{
 		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{
 			flagset.ObjectType: true,
-		}),
+		}, generators.CSharpReservedWords),
 	}
 }
+
+// Accessors returns the names of the generated accessors of the flags, e.g. EnableFeatureAAsync.
+func Accessors(fs *flagset.Flagset) map[string]string {
+	return NewGenerator(fs).Accessors(func(flag flagset.Flag, id generators.Identifiers) string {
+		return id.Pascal(flag.Key) + "Async"
+	})
+}
@@ -0,0 +1,97 @@
+package dart
+
+import (
+	_ "embed"
+	"text/template"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+)
+
+type DartGenerator struct {
+	generators.CommonGenerator
+}
+
+type Params struct {
+	// Flutter enables the generation of Flutter widgets that rebuild on provider events
+	Flutter bool
+}
+
+// reservedWords include the client field of the generated class and the members it inherits from Object,
+// which a flag accessor must not shadow.
+var reservedWords = generators.DartReservedWords.With("client", "hashCode", "noSuchMethod", "runtimeType", "toString")
+
+//go:embed dart.tmpl
+var dartTmpl string
+
+//go:embed flutter.tmpl
+var flutterTmpl string
+
+func openFeatureType(t flagset.FlagType) string {
+	switch t {
+	case flagset.IntType:
+		return "int"
+	case flagset.FloatType:
+		return "double"
+	case flagset.BoolType:
+		return "bool"
+	case flagset.StringType:
+		return "String"
+	default:
+		return ""
+	}
+}
+
+func methodType(t flagset.FlagType) string {
+	switch t {
+	case flagset.IntType:
+		return "Integer"
+	case flagset.FloatType:
+		return "Double"
+	case flagset.BoolType:
+		return "Boolean"
+	case flagset.StringType:
+		return "String"
+	default:
+		return ""
+	}
+}
+
+func (g *DartGenerator) Generate(params *generators.Params[Params]) error {
+	funcs := template.FuncMap{
+		"OpenFeatureType":    openFeatureType,
+		"MethodType":         methodType,
+		"FormatDefaultValue": generators.DefaultValueFormatter(generators.QuoteDart),
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath: params.OutputPath,
+		Custom:     params.Custom,
+	}
+
+	if err := g.GenerateFile(funcs, dartTmpl, newParams, "openfeature.g.dart"); err != nil {
+		return err
+	}
+
+	if !params.Custom.Flutter {
+		return nil
+	}
+
+	return g.GenerateFile(funcs, flutterTmpl, newParams, "openfeature_widgets.g.dart")
+}
+
+// NewGenerator creates a generator for Dart.
+func NewGenerator(fs *flagset.Flagset) *DartGenerator {
+	return &DartGenerator{
+		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{
+			flagset.ObjectType: true,
+		}, reservedWords),
+	}
+}
+
+// Accessors returns the names of the generated accessors of the flags, e.g. enableFeatureA.
+func Accessors(fs *flagset.Flagset) map[string]string {
+	return NewGenerator(fs).Accessors(func(flag flagset.Flag, id generators.Identifiers) string {
+		return id.Camel(flag.Key)
+	})
+}
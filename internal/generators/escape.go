@@ -0,0 +1,142 @@
+package generators
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"unicode"
+)
+
+// singleLine joins the lines of a value, so that it cannot end a line comment
+// or break the layout of a doc comment.
+func singleLine(value any) string {
+	return strings.Join(strings.Fields(fmt.Sprint(value)), " ")
+}
+
+// escapeComment makes a value safe to embed in line comments, such as Go, Ruby or Dart comments.
+func escapeComment(value any) string {
+	return singleLine(value)
+}
+
+// escapeBlockComment makes a value safe to embed in block doc comments (JSDoc, Javadoc, PHPDoc).
+func escapeBlockComment(value any) string {
+	return strings.ReplaceAll(singleLine(value), "*/", "*\\/")
+}
+
+// escapeXMLDoc makes a value safe to embed in C# XML documentation comments.
+func escapeXMLDoc(value any) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(singleLine(value))
+}
+
+// escapeDocstring makes a value safe to embed in a Python docstring delimited by triple double quotes.
+func escapeDocstring(value any) string {
+	s := strings.ReplaceAll(singleLine(value), "\\", "\\\\")
+	return strings.ReplaceAll(s, `"""`, `\"\"\"`)
+}
+
+// codeSpan wraps a value in a markdown code span. Backslashes don't escape backticks in code spans,
+// so the fence is made longer than the longest run of backticks in the value instead.
+func codeSpan(value any) string {
+	s := fmt.Sprint(value)
+	longest, run := 0, 0
+	for _, r := range s {
+		if r != '`' {
+			run = 0
+			continue
+		}
+		run++
+		longest = max(longest, run)
+	}
+	fence := strings.Repeat("`", longest+1)
+	if strings.HasPrefix(s, "`") || strings.HasSuffix(s, "`") {
+		s = " " + s + " "
+	}
+	return fence + s + fence
+}
+
+// quote returns s in quotes, replacing the characters of the escapes map by their escape sequences
+// and the other control characters by escape(r).
+func quote(s, quotes string, escapes map[rune]string, escape func(r rune) string) string {
+	var b strings.Builder
+	b.WriteString(quotes)
+	for _, r := range s {
+		if e, ok := escapes[r]; ok {
+			b.WriteString(e)
+		} else if unicode.IsControl(r) {
+			b.WriteString(escape(r))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	b.WriteString(quotes)
+	return b.String()
+}
+
+// commonEscapes are the escape sequences of double-quoted string literals shared by the C family of languages
+var commonEscapes = map[rune]string{
+	'\\': `\\`, '"': `\"`, '\n': `\n`, '\r': `\r`, '\t': `\t`, '\b': `\b`, '\f': `\f`,
+}
+
+var (
+	javaScriptEscapes = with(commonEscapes, map[rune]string{'\v': `\v`, '\u2028': `\u2028`, '\u2029': `\u2029`})
+	cSharpEscapes     = with(commonEscapes, map[rune]string{
+		'\a': `\a`, '\v': `\v`, '\u0085': `\u0085`, '\u2028': `\u2028`, '\u2029': `\u2029`,
+	})
+	dartEscapes   = with(commonEscapes, map[rune]string{'"': `"`, '\'': `\'`, '$': `\$`, '\v': `\v`})
+	pythonEscapes = with(commonEscapes, map[rune]string{'\a': `\a`, '\v': `\v`})
+	rubyEscapes   = with(commonEscapes, map[rune]string{'#': `\#`, '\a': `\a`, '\v': `\v`})
+	// PHP has no escape sequence for backspaces, they are escaped as other control characters
+	phpEscapes = map[rune]string{
+		'\\': `\\`, '"': `\"`, '$': `\$`, '\n': `\n`, '\r': `\r`, '\t': `\t`, '\v': `\v`, '\f': `\f`,
+	}
+)
+
+func with(escapes, more map[rune]string) map[rune]string {
+	merged := maps.Clone(escapes)
+	maps.Copy(merged, more)
+	return merged
+}
+
+// QuoteJavaScript returns a double-quoted JavaScript or TypeScript string literal.
+func QuoteJavaScript(s string) string {
+	return quote(s, `"`, javaScriptEscapes, func(r rune) string { return fmt.Sprintf(`\u%04X`, r) })
+}
+
+// QuoteJava returns a double-quoted Java string literal. Control characters are escaped as octal escapes,
+// since unicode escapes are translated before the string literal is parsed, e.g. \u000a ends the line.
+func QuoteJava(s string) string {
+	return quote(s, `"`, commonEscapes, func(r rune) string { return fmt.Sprintf(`\%03o`, r) })
+}
+
+// QuoteCSharp returns a double-quoted C# string literal.
+func QuoteCSharp(s string) string {
+	return quote(s, `"`, cSharpEscapes, func(r rune) string { return fmt.Sprintf(`\u%04X`, r) })
+}
+
+// QuotePHP returns a single-quoted PHP string literal, which is not subject to variable interpolation,
+// or a double-quoted one with escaped variables if the string contains control characters.
+func QuotePHP(s string) string {
+	if !strings.ContainsFunc(s, unicode.IsControl) {
+		return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+	}
+	return quote(s, `"`, phpEscapes, func(r rune) string { return fmt.Sprintf(`\x%02X`, r) })
+}
+
+// QuoteRuby returns a single-quoted Ruby string literal, which is not subject to interpolation,
+// or a double-quoted one with escaped interpolation if the string contains control characters.
+func QuoteRuby(s string) string {
+	if !strings.ContainsFunc(s, unicode.IsControl) {
+		return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+	}
+	return quote(s, `"`, rubyEscapes, func(r rune) string { return fmt.Sprintf(`\x%02X`, r) })
+}
+
+// QuoteDart returns a single-quoted Dart string literal with interpolation escaped.
+func QuoteDart(s string) string {
+	return quote(s, `'`, dartEscapes, func(r rune) string { return fmt.Sprintf(`\u{%X}`, r) })
+}
+
+// QuotePython returns a double-quoted Python string literal.
+func QuotePython(s string) string {
+	return quote(s, `"`, pythonEscapes, func(r rune) string { return fmt.Sprintf(`\x%02x`, r) })
+}
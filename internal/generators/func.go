@@ -9,19 +9,11 @@ import (
 	"golang.org/x/text/cases"
 )
 
-func defaultFuncs() template.FuncMap {
+func defaultFuncs(reserved ReservedWords) template.FuncMap {
 	// Update the contributing doc when adding a new function
-	return template.FuncMap{
-		// Remapping ToCamel to ToPascal to match the expected behavior
-		// Ref: https://github.com/iancoleman/strcase/issues/53
-		"ToPascal": strcase.ToCamel,
-		// Remapping ToLowerCamel to ToCamel to match the expected behavior
-		// Ref: See above
-		"ToCamel":          strcase.ToLowerCamel,
+	funcs := template.FuncMap{
 		"ToKebab":          strcase.ToKebab,
 		"ToScreamingKebab": strcase.ToScreamingKebab,
-		"ToSnake":          strcase.ToSnake,
-		"ToScreamingSnake": strcase.ToScreamingSnake,
 		"ToUpper":          strings.ToUpper,
 		"ToLower":          strings.ToLower,
 		"Title":            cases.Title,
@@ -32,7 +24,23 @@ func defaultFuncs() template.FuncMap {
 			}
 			return input
 		},
+		"EscapeComment":      escapeComment,
+		"EscapeBlockComment": escapeBlockComment,
+		"EscapeXMLDoc":       escapeXMLDoc,
+		"EscapeDocstring":    escapeDocstring,
+		"CodeSpan":           codeSpan,
+		"QuoteJavaScript":    QuoteJavaScript,
+		"QuoteJava":          QuoteJava,
+		"QuoteCSharp":        QuoteCSharp,
+		"QuotePHP":           QuotePHP,
+		"QuoteRuby":          QuoteRuby,
+		"QuoteDart":          QuoteDart,
+		"QuotePython":        QuotePython,
 	}
+	for name, f := range identifierFuncs(reserved) {
+		funcs[name] = f
+	}
+	return funcs
 }
 
 func init() {
@@ -24,6 +24,8 @@ const (
 
 type CommonGenerator struct {
 	Flagset *flagset.Flagset
+	// ReservedWords are mangled when a flag key converts to one of them
+	ReservedWords ReservedWords
 }
 
 type Params[T any] struct {
@@ -37,14 +39,19 @@ type TemplateData struct {
 }
 
 // NewGenerator creates a new generator
-func NewGenerator(flagset *flagset.Flagset, UnsupportedFlagTypes map[flagset.FlagType]bool) *CommonGenerator {
+func NewGenerator(flagset *flagset.Flagset, UnsupportedFlagTypes map[flagset.FlagType]bool, reservedWords ReservedWords) *CommonGenerator {
 	return &CommonGenerator{
-		Flagset: flagset.Filter(UnsupportedFlagTypes),
+		Flagset:       flagset.Filter(UnsupportedFlagTypes),
+		ReservedWords: reservedWords,
 	}
 }
 
 func (g *CommonGenerator) GenerateFile(customFunc template.FuncMap, tmpl string, params *Params[any], name string) error {
-	funcs := defaultFuncs()
+	if err := g.checkIdentifiers(identifierFuncs(g.ReservedWords), tmpl); err != nil {
+		return err
+	}
+
+	funcs := defaultFuncs(g.ReservedWords)
 	maps.Copy(funcs, customFunc)
 
 	logger.Default.Debug(fmt.Sprintf("Generating file: %s", name))
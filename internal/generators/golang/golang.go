@@ -14,12 +14,21 @@ type GolangGenerator struct {
 }
 
 type Params struct {
-	GoPackage string
+	GoPackage   string
+	TestHelpers bool
 }
 
+// reservedWords are the identifiers declared by the templates next to the flag accessors.
+var reservedWords = generators.GoReservedWords.With(
+	"Client", "NewClient", "NewDomainClient", "EvalContext", "NewEvalContext",
+)
+
 //go:embed golang.tmpl
 var golangTmpl string
 
+//go:embed testflags.tmpl
+var testflagsTmpl string
+
 func openFeatureType(t flagset.FlagType) string {
 	switch t {
 	case flagset.IntType:
@@ -60,11 +69,22 @@ func supportImports(flags []flagset.Flag) []string {
 	return res
 }
 
+// requiredContext reports whether any of the context attributes is required.
+func requiredContext(attributes []flagset.ContextAttribute) bool {
+	for _, attribute := range attributes {
+		if attribute.Required {
+			return true
+		}
+	}
+	return false
+}
+
 func (g *GolangGenerator) Generate(params *generators.Params[Params]) error {
 	funcs := template.FuncMap{
 		"SupportImports":  supportImports,
 		"OpenFeatureType": openFeatureType,
 		"TypeString":      typeString,
+		"RequiredContext": requiredContext,
 	}
 
 	newParams := &generators.Params[any]{
@@ -74,7 +94,15 @@ func (g *GolangGenerator) Generate(params *generators.Params[Params]) error {
 		},
 	}
 
-	return g.GenerateFile(funcs, golangTmpl, newParams, params.Custom.GoPackage+".go")
+	if err := g.GenerateFile(funcs, golangTmpl, newParams, params.Custom.GoPackage+".go"); err != nil {
+		return err
+	}
+
+	if !params.Custom.TestHelpers {
+		return nil
+	}
+
+	return g.GenerateFile(funcs, testflagsTmpl, newParams, "testflags/testflags.go")
 }
 
 // NewGenerator creates a generator for Go.
@@ -82,6 +110,23 @@ func NewGenerator(fs *flagset.Flagset) *GolangGenerator {
 	return &GolangGenerator{
 		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{
 			flagset.ObjectType: true,
-		}),
+		}, reservedWords),
 	}
 }
+
+// Accessors returns the names of the generated accessors of the flags, e.g. EnableFeatureA.
+func Accessors(fs *flagset.Flagset) map[string]string {
+	return NewGenerator(fs).Accessors(func(flag flagset.Flag, id generators.Identifiers) string {
+		if flag.Group != "" {
+			return id.Pascal(flag.Group) + "." + id.Pascal(flag.Name())
+		}
+		return id.Pascal(flag.Key)
+	})
+}
+
+// ClientMethods returns the names of the accessor methods of the generated Client, e.g. CheckoutNewFlow.
+func ClientMethods(fs *flagset.Flagset) map[string]string {
+	return NewGenerator(fs).Accessors(func(flag flagset.Flag, id generators.Identifiers) string {
+		return id.Pascal(flag.Key)
+	})
+}
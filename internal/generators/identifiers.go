@@ -0,0 +1,236 @@
+package generators
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/iancoleman/strcase"
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// ReservedWords is a set of identifiers that generated code must not declare,
+// such as the keywords of the target language or names the templates already use.
+type ReservedWords map[string]bool
+
+func newReservedWords(words ...string) ReservedWords {
+	reserved := make(ReservedWords, len(words))
+	for _, word := range words {
+		reserved[word] = true
+	}
+	return reserved
+}
+
+// With returns a copy of the reserved words extended by the given words.
+func (r ReservedWords) With(words ...string) ReservedWords {
+	reserved := newReservedWords(words...)
+	for word := range r {
+		reserved[word] = true
+	}
+	return reserved
+}
+
+// Keywords of the target languages.
+var (
+	GoReservedWords = newReservedWords(
+		"break", "case", "chan", "const", "continue", "default", "defer", "else", "fallthrough", "for", "func",
+		"go", "goto", "if", "import", "interface", "map", "package", "range", "return", "select", "struct",
+		"switch", "type", "var",
+	)
+	JavaScriptReservedWords = newReservedWords(
+		"await", "break", "case", "catch", "class", "const", "continue", "debugger", "default", "delete", "do",
+		"else", "enum", "export", "extends", "false", "finally", "for", "function", "if", "implements", "import",
+		"in", "instanceof", "interface", "let", "new", "null", "package", "private", "protected", "public",
+		"return", "static", "super", "switch", "this", "throw", "true", "try", "typeof", "var", "void", "while",
+		"with", "yield",
+	)
+	JavaReservedWords = newReservedWords(
+		"abstract", "assert", "boolean", "break", "byte", "case", "catch", "char", "class", "const", "continue",
+		"default", "do", "double", "else", "enum", "extends", "false", "final", "finally", "float", "for", "goto",
+		"if", "implements", "import", "instanceof", "int", "interface", "long", "native", "new", "null", "package",
+		"private", "protected", "public", "return", "short", "static", "strictfp", "super", "switch",
+		"synchronized", "this", "throw", "throws", "transient", "true", "try", "var", "void", "volatile", "while",
+		"yield",
+	)
+	CSharpReservedWords = newReservedWords(
+		"abstract", "as", "base", "bool", "break", "byte", "case", "catch", "char", "checked", "class", "const",
+		"continue", "decimal", "default", "delegate", "do", "double", "else", "enum", "event", "explicit",
+		"extern", "false", "finally", "fixed", "float", "for", "foreach", "goto", "if", "implicit", "in", "int",
+		"interface", "internal", "is", "lock", "long", "namespace", "new", "null", "object", "operator", "out",
+		"override", "params", "private", "protected", "public", "readonly", "ref", "return", "sbyte", "sealed",
+		"short", "sizeof", "stackalloc", "static", "string", "struct", "switch", "this", "throw", "true", "try",
+		"typeof", "uint", "ulong", "unchecked", "unsafe", "ushort", "using", "virtual", "void", "volatile",
+		"while",
+	)
+	PythonReservedWords = newReservedWords(
+		"False", "None", "True", "and", "as", "assert", "async", "await", "break", "class", "continue", "def",
+		"del", "elif", "else", "except", "finally", "for", "from", "global", "if", "import", "in", "is",
+		"lambda", "nonlocal", "not", "or", "pass", "raise", "return", "try", "while", "with", "yield",
+	)
+	RubyReservedWords = newReservedWords(
+		"BEGIN", "END", "alias", "and", "begin", "break", "case", "class", "def", "defined?", "do", "else",
+		"elsif", "end", "ensure", "false", "for", "if", "in", "module", "next", "nil", "not", "or", "redo",
+		"rescue", "retry", "return", "self", "super", "then", "true", "undef", "unless", "until", "when",
+		"while", "yield",
+	)
+	PHPReservedWords = newReservedWords(
+		"__call", "__callStatic", "__clone", "__construct", "__debugInfo", "__destruct", "__get", "__invoke",
+		"__isset", "__serialize", "__set", "__set_state", "__sleep", "__toString", "__unserialize", "__unset",
+		"__wakeup", "abstract", "and", "array", "as", "break", "callable", "case", "catch", "class", "clone",
+		"const", "continue", "declare", "default", "do", "echo", "else", "elseif", "empty", "enddeclare",
+		"endfor", "endforeach", "endif", "endswitch", "endwhile", "enum", "eval", "exit", "extends", "final",
+		"finally", "fn", "for", "foreach", "function", "global", "goto", "if", "implements", "include",
+		"include_once", "instanceof", "insteadof", "interface", "isset", "list", "match", "namespace", "new", "or",
+		"print", "private", "protected", "public", "readonly", "require", "require_once", "return", "static",
+		"switch", "throw", "trait", "try", "unset", "use", "var", "while", "xor", "yield",
+	)
+	DartReservedWords = newReservedWords(
+		"assert", "break", "case", "catch", "class", "const", "continue", "default", "do", "else", "enum",
+		"extends", "false", "final", "finally", "for", "if", "in", "is", "new", "null", "rethrow", "return",
+		"super", "switch", "this", "throw", "true", "try", "var", "void", "while", "with",
+	)
+)
+
+// identifierFuncs returns the case conversion template functions, which turn
+// flag keys into valid identifiers that are not reserved in the target language.
+func identifierFuncs(reserved ReservedWords) map[string]func(string) string {
+	return map[string]func(string) string{
+		// Remapping ToCamel to ToPascal to match the expected behavior
+		// Ref: https://github.com/iancoleman/strcase/issues/53
+		"ToPascal": safeIdentifier(strcase.ToCamel, reserved),
+		// Remapping ToLowerCamel to ToCamel to match the expected behavior
+		// Ref: See above
+		"ToCamel":          safeIdentifier(strcase.ToLowerCamel, reserved),
+		"ToSnake":          safeIdentifier(strcase.ToSnake, reserved),
+		"ToScreamingSnake": safeIdentifier(strcase.ToScreamingSnake, reserved),
+	}
+}
+
+// Identifiers are the case conversion template functions of a generator, see identifierFuncs.
+type Identifiers struct {
+	Pascal         func(string) string
+	Camel          func(string) string
+	Snake          func(string) string
+	ScreamingSnake func(string) string
+}
+
+// Accessors returns the name of the generated accessor of each flag the generator supports,
+// keyed by flag key. The name is built by the accessor function from the case conversions
+// of the generator, so that it matches the generated code.
+func (g *CommonGenerator) Accessors(accessor func(flag flagset.Flag, id Identifiers) string) map[string]string {
+	funcs := identifierFuncs(g.ReservedWords)
+	id := Identifiers{
+		Pascal:         funcs["ToPascal"],
+		Camel:          funcs["ToCamel"],
+		Snake:          funcs["ToSnake"],
+		ScreamingSnake: funcs["ToScreamingSnake"],
+	}
+	accessors := make(map[string]string, len(g.Flagset.Flags))
+	for _, flag := range g.Flagset.Flags {
+		accessors[flag.Key] = accessor(flag, id)
+	}
+	return accessors
+}
+
+// safeIdentifier wraps a case conversion so that its result is a valid identifier:
+// characters other than letters, digits and underscores are replaced by underscores,
+// a leading digit is prefixed with "flag", and reserved words get a trailing underscore.
+func safeIdentifier(toCase func(string) string, reserved ReservedWords) func(string) string {
+	return func(s string) string {
+		ident := sanitizeIdentifier(toCase(s))
+		if ident == "" || unicode.IsDigit([]rune(ident)[0]) {
+			ident = sanitizeIdentifier(toCase("flag_" + s))
+		}
+		if reserved[ident] {
+			ident += "_"
+		}
+		return ident
+	}
+}
+
+func sanitizeIdentifier(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, s)
+}
+
+// JavaScriptPropertyName returns the name as a property name of a JavaScript object,
+// quoting it if it isn't a valid identifier.
+func JavaScriptPropertyName(name string) string {
+	if sanitizeIdentifier(name) == name && name != "" && !unicode.IsDigit([]rune(name)[0]) {
+		return name
+	}
+	return QuoteJavaScript(name)
+}
+
+// checkIdentifiers returns an error if two flag keys map to the same identifier
+// in any of the case conversions used by the template, since the generated code would not compile.
+// Templates that generate groups as nested namespaces are additionally checked per namespace.
+func (g *CommonGenerator) checkIdentifiers(funcs map[string]func(string) string, tmpl string) error {
+	names := make([]string, 0, len(funcs))
+	for name := range funcs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !strings.Contains(tmpl, name) {
+			continue
+		}
+		toIdentifier := funcs[name]
+		keys := make([]string, 0, len(g.Flagset.Flags))
+		for _, flag := range g.Flagset.Flags {
+			keys = append(keys, flag.Key)
+		}
+		if err := checkUnique(toIdentifier, keys, "flag keys"); err != nil {
+			return err
+		}
+		if strings.Contains(tmpl, ".Flagset.Context") {
+			attributes := make([]string, 0, len(g.Flagset.Context))
+			for _, attribute := range g.Flagset.Context {
+				attributes = append(attributes, attribute.Name)
+			}
+			if err := checkUnique(toIdentifier, attributes, "context attributes"); err != nil {
+				return err
+			}
+		}
+		if !strings.Contains(tmpl, ".Groups") {
+			continue
+		}
+
+		var topLevel []string
+		for _, flag := range g.Flagset.Ungrouped() {
+			topLevel = append(topLevel, flag.Key)
+		}
+		for _, group := range g.Flagset.Groups() {
+			topLevel = append(topLevel, group.Name)
+			names := make([]string, 0, len(group.Flags))
+			for _, flag := range group.Flags {
+				names = append(names, flag.Name())
+			}
+			if err := checkUnique(toIdentifier, names, "flag keys"); err != nil {
+				return err
+			}
+		}
+		if err := checkUnique(toIdentifier, topLevel, "flag keys"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkUnique(toIdentifier func(string) string, keys []string, kind string) error {
+	seen := make(map[string]string, len(keys))
+	for _, key := range keys {
+		ident := toIdentifier(key)
+		if other, ok := seen[ident]; ok {
+			return fmt.Errorf("%s %q and %q both map to the identifier %q, rename one of them", kind, other, key, ident)
+		}
+		seen[ident] = key
+	}
+	return nil
+}
@@ -2,7 +2,6 @@ package java
 
 import (
 	_ "embed"
-	"fmt"
 	"text/template"
 
 	"github.com/open-feature/cli/internal/flagset"
@@ -16,11 +15,26 @@ type JavaGenerator struct {
 type Params struct {
 	// Add Java parameters here if needed
 	JavaPackage string
+	TestHelpers bool
+	// Spring enables the generation of a Spring configuration, a conditional annotation and a flag key enum
+	Spring bool
 }
 
 //go:embed java.tmpl
 var javaTmpl string
 
+//go:embed java.testing.tmpl
+var javaTestingTmpl string
+
+//go:embed java.flagkey.tmpl
+var flagKeyTmpl string
+
+//go:embed java.spring.configuration.tmpl
+var springConfigurationTmpl string
+
+//go:embed java.spring.conditional.tmpl
+var springConditionalTmpl string
+
 func openFeatureType(t flagset.FlagType) string {
 	switch t {
 	case flagset.IntType:
@@ -36,24 +50,28 @@ func openFeatureType(t flagset.FlagType) string {
 	}
 }
 
-func formatDefaultValueForJava(flag flagset.Flag) string {
-	switch flag.Type {
-	case flagset.StringType:
-		return fmt.Sprintf("\"%s\"", flag.DefaultValue)
+// primitiveType returns the primitive counterpart of openFeatureType,
+// so that integer literals can be passed where a double is expected.
+func primitiveType(t flagset.FlagType) string {
+	switch t {
+	case flagset.IntType:
+		return "int"
+	case flagset.FloatType:
+		return "double"
 	case flagset.BoolType:
-		if flag.DefaultValue == true {
-			return "true"
-		}
-		return "false"
+		return "boolean"
+	case flagset.StringType:
+		return "String"
 	default:
-		return fmt.Sprintf("%v", flag.DefaultValue)
+		return ""
 	}
 }
 
 func (g *JavaGenerator) Generate(params *generators.Params[Params]) error {
 	funcs := template.FuncMap{
 		"OpenFeatureType":    openFeatureType,
-		"FormatDefaultValue": formatDefaultValueForJava,
+		"FormatDefaultValue": generators.DefaultValueFormatter(generators.QuoteJava),
+		"PrimitiveType":      primitiveType,
 	}
 
 	newParams := &generators.Params[any]{
@@ -61,7 +79,27 @@ func (g *JavaGenerator) Generate(params *generators.Params[Params]) error {
 		Custom:     params.Custom,
 	}
 
-	return g.GenerateFile(funcs, javaTmpl, newParams, "OpenFeature.java")
+	if err := g.GenerateFile(funcs, javaTmpl, newParams, "OpenFeature.java"); err != nil {
+		return err
+	}
+
+	if params.Custom.TestHelpers {
+		if err := g.GenerateFile(funcs, javaTestingTmpl, newParams, "OpenFeatureTestFlags.java"); err != nil {
+			return err
+		}
+	}
+
+	if !params.Custom.Spring {
+		return nil
+	}
+
+	if err := g.GenerateFile(funcs, flagKeyTmpl, newParams, "FlagKey.java"); err != nil {
+		return err
+	}
+	if err := g.GenerateFile(funcs, springConfigurationTmpl, newParams, "OpenFeatureConfiguration.java"); err != nil {
+		return err
+	}
+	return g.GenerateFile(funcs, springConditionalTmpl, newParams, "ConditionalOnFeatureFlag.java")
 }
 
 // NewGenerator creates a generator for Java.
@@ -69,6 +107,16 @@ func NewGenerator(fs *flagset.Flagset) *JavaGenerator {
 	return &JavaGenerator{
 		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{
 			flagset.ObjectType: true,
-		}),
+		}, generators.JavaReservedWords),
 	}
 }
+
+// Accessors returns the names of the generated accessors of the flags, e.g. enableFeatureA.
+func Accessors(fs *flagset.Flagset) map[string]string {
+	return NewGenerator(fs).Accessors(func(flag flagset.Flag, id generators.Identifiers) string {
+		if flag.Group != "" {
+			return id.Camel(flag.Group) + "()." + id.Camel(flag.Name())
+		}
+		return id.Camel(flag.Key)
+	})
+}
@@ -3,6 +3,7 @@ package generators
 import (
 	"sort"
 
+	"github.com/open-feature/cli/internal/flagset"
 	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
 )
@@ -18,16 +19,37 @@ type GeneratorInfo struct {
 	Creator     GeneratorCreator
 }
 
+// AccessorsFunc returns the names of the accessors a generator generates for the flags, keyed by flag key.
+// Flags of types the generator doesn't support have no accessor.
+type AccessorsFunc func(fs *flagset.Flagset) map[string]string
+
 // GeneratorManager maintains a registry of available generators
 type GeneratorManager struct {
 	generators map[string]GeneratorInfo
+	accessors  map[string]AccessorsFunc
 }
 
 // NewGeneratorManager creates a new generator manager
 func NewGeneratorManager() *GeneratorManager {
 	return &GeneratorManager{
 		generators: make(map[string]GeneratorInfo),
+		accessors:  make(map[string]AccessorsFunc),
+	}
+}
+
+// RegisterAccessors adds the accessor names of the generator with the given name to the registry
+func (m *GeneratorManager) RegisterAccessors(name string, accessors AccessorsFunc) {
+	m.accessors[name] = accessors
+}
+
+// GetAccessors returns the names of the generated accessors of the flags,
+// keyed by generator name and flag key
+func (m *GeneratorManager) GetAccessors(fs *flagset.Flagset) map[string]map[string]string {
+	accessors := make(map[string]map[string]string, len(m.accessors))
+	for name, accessorsFunc := range m.accessors {
+		accessors[name] = accessorsFunc(fs)
 	}
+	return accessors
 }
 
 // Register adds a generator to the registry
@@ -18,24 +18,10 @@ type Params struct {
 //go:embed nestjs.tmpl
 var nestJsTmpl string
 
-func openFeatureType(t flagset.FlagType) string {
-	switch t {
-	case flagset.IntType:
-		fallthrough
-	case flagset.FloatType:
-		return "number"
-	case flagset.BoolType:
-		return "boolean"
-	case flagset.StringType:
-		return "string"
-	default:
-		return ""
-	}
-}
-
 func (g *NestJsGenerator) Generate(params *generators.Params[Params]) error {
 	funcs := template.FuncMap{
-		"OpenFeatureType": openFeatureType,
+		"OpenFeatureType":    generators.TypeScriptType,
+		"FormatDefaultValue": generators.DefaultValueFormatter(generators.QuoteJavaScript),
 	}
 
 	newParams := &generators.Params[any]{
@@ -51,6 +37,13 @@ func NewGenerator(fs *flagset.Flagset) *NestJsGenerator {
 	return &NestJsGenerator{
 		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{
 			flagset.ObjectType: true,
-		}),
+		}, generators.JavaScriptReservedWords),
 	}
 }
+
+// Accessors returns the names of the generated accessors of the flags, e.g. EnableFeatureA.
+func Accessors(fs *flagset.Flagset) map[string]string {
+	return NewGenerator(fs).Accessors(func(flag flagset.Flag, id generators.Identifiers) string {
+		return id.Pascal(flag.Key)
+	})
+}
@@ -0,0 +1,56 @@
+package nextjs
+
+import (
+	_ "embed"
+	"text/template"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+)
+
+type NextjsGenerator struct {
+	generators.CommonGenerator
+}
+
+type Params struct {
+}
+
+//go:embed nextjs.server.tmpl
+var nextjsServerTmpl string
+
+//go:embed nextjs.client.tmpl
+var nextjsClientTmpl string
+
+func (g *NextjsGenerator) Generate(params *generators.Params[Params]) error {
+	funcs := template.FuncMap{
+		"OpenFeatureType":    generators.TypeScriptType,
+		"FormatDefaultValue": generators.DefaultValueFormatter(generators.QuoteJavaScript),
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath: params.OutputPath,
+		Custom:     Params{},
+	}
+
+	if err := g.GenerateFile(funcs, nextjsServerTmpl, newParams, "openfeature.server.ts"); err != nil {
+		return err
+	}
+
+	return g.GenerateFile(funcs, nextjsClientTmpl, newParams, "openfeature.client.tsx")
+}
+
+// NewGenerator creates a generator for Next.js.
+func NewGenerator(fs *flagset.Flagset) *NextjsGenerator {
+	return &NextjsGenerator{
+		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{
+			flagset.ObjectType: true,
+		}, generators.JavaScriptReservedWords),
+	}
+}
+
+// Accessors returns the names of the generated accessors of the flags, e.g. useEnableFeatureA.
+func Accessors(fs *flagset.Flagset) map[string]string {
+	return NewGenerator(fs).Accessors(func(flag flagset.Flag, id generators.Identifiers) string {
+		return "use" + id.Pascal(flag.Key)
+	})
+}
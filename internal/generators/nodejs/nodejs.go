@@ -13,29 +13,28 @@ type NodejsGenerator struct {
 }
 
 type Params struct {
+	TestHelpers bool
 }
 
 //go:embed nodejs.tmpl
 var nodejsTmpl string
 
-func openFeatureType(t flagset.FlagType) string {
-	switch t {
-	case flagset.IntType:
-		fallthrough
-	case flagset.FloatType:
-		return "number"
-	case flagset.BoolType:
-		return "boolean"
-	case flagset.StringType:
-		return "string"
-	default:
-		return ""
-	}
-}
+//go:embed nodejs.testing.tmpl
+var nodejsTestingTmpl string
 
 func (g *NodejsGenerator) Generate(params *generators.Params[Params]) error {
 	funcs := template.FuncMap{
-		"OpenFeatureType": openFeatureType,
+		"OpenFeatureType":    generators.TypeScriptType,
+		"FormatDefaultValue": generators.DefaultValueFormatter(generators.QuoteJavaScript),
+		"PropertyName":       generators.JavaScriptPropertyName,
+		// The attributes of the context of an evaluation are merged with the context of the client,
+		// so required attributes can't be enforced on it.
+		"EvaluationContextType": func() string {
+			if len(g.Flagset.Context) > 0 {
+				return "Partial<GeneratedEvaluationContext>"
+			}
+			return "EvaluationContext"
+		},
 	}
 
 	newParams := &generators.Params[any]{
@@ -43,7 +42,15 @@ func (g *NodejsGenerator) Generate(params *generators.Params[Params]) error {
 		Custom:     Params{},
 	}
 
-	return g.GenerateFile(funcs, nodejsTmpl, newParams, "openfeature.ts")
+	if err := g.GenerateFile(funcs, nodejsTmpl, newParams, "openfeature.ts"); err != nil {
+		return err
+	}
+
+	if !params.Custom.TestHelpers {
+		return nil
+	}
+
+	return g.GenerateFile(funcs, nodejsTestingTmpl, newParams, "openfeature.testing.ts")
 }
 
 // NewGenerator creates a generator for NodeJS.
@@ -51,6 +58,16 @@ func NewGenerator(fs *flagset.Flagset) *NodejsGenerator {
 	return &NodejsGenerator{
 		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{
 			flagset.ObjectType: true,
-		}),
+		}, generators.JavaScriptReservedWords),
 	}
 }
+
+// Accessors returns the names of the generated accessors of the flags, e.g. enableFeatureA.
+func Accessors(fs *flagset.Flagset) map[string]string {
+	return NewGenerator(fs).Accessors(func(flag flagset.Flag, id generators.Identifiers) string {
+		if flag.Group != "" {
+			return id.Camel(flag.Group) + "." + id.Camel(flag.Name())
+		}
+		return id.Camel(flag.Key)
+	})
+}
@@ -0,0 +1,85 @@
+package php
+
+import (
+	_ "embed"
+	"text/template"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+)
+
+type PhpGenerator struct {
+	generators.CommonGenerator
+}
+
+type Params struct {
+	// Namespace is the PSR-4 namespace of the generated class
+	Namespace string
+}
+
+// reservedWords include the factory method of the generated class, which a flag accessor must not replace.
+var reservedWords = generators.PHPReservedWords.With("create")
+
+//go:embed php.tmpl
+var phpTmpl string
+
+func openFeatureType(t flagset.FlagType) string {
+	switch t {
+	case flagset.IntType:
+		return "int"
+	case flagset.FloatType:
+		return "float"
+	case flagset.BoolType:
+		return "bool"
+	case flagset.StringType:
+		return "string"
+	default:
+		return ""
+	}
+}
+
+func methodType(t flagset.FlagType) string {
+	switch t {
+	case flagset.IntType:
+		return "Integer"
+	case flagset.FloatType:
+		return "Float"
+	case flagset.BoolType:
+		return "Boolean"
+	case flagset.StringType:
+		return "String"
+	default:
+		return ""
+	}
+}
+
+func (g *PhpGenerator) Generate(params *generators.Params[Params]) error {
+	funcs := template.FuncMap{
+		"OpenFeatureType":    openFeatureType,
+		"MethodType":         methodType,
+		"FormatDefaultValue": generators.DefaultValueFormatter(generators.QuotePHP),
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath: params.OutputPath,
+		Custom:     params.Custom,
+	}
+
+	return g.GenerateFile(funcs, phpTmpl, newParams, "GeneratedClient.php")
+}
+
+// NewGenerator creates a generator for PHP.
+func NewGenerator(fs *flagset.Flagset) *PhpGenerator {
+	return &PhpGenerator{
+		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{
+			flagset.ObjectType: true,
+		}, reservedWords),
+	}
+}
+
+// Accessors returns the names of the generated accessors of the flags, e.g. enableFeatureA.
+func Accessors(fs *flagset.Flagset) map[string]string {
+	return NewGenerator(fs).Accessors(func(flag flagset.Flag, id generators.Identifiers) string {
+		return id.Camel(flag.Key)
+	})
+}
@@ -2,6 +2,8 @@ package python
 
 import (
 	_ "embed"
+	"fmt"
+	"strings"
 	"text/template"
 
 	"github.com/open-feature/cli/internal/flagset"
@@ -13,11 +15,34 @@ type PythonGenerator struct {
 }
 
 type Params struct {
+	TestHelpers bool
+	// ModuleName is the name of the generated module or package
+	ModuleName string
+	// Package enables the generation of a package with a type stub and a py.typed marker
+	Package bool
+	// API selects whether synchronous, asynchronous or both kinds of accessors are generated
+	API string
 }
 
+// Supported values of Params.API.
+const (
+	APIBoth  = "both"
+	APISync  = "sync"
+	APIAsync = "async"
+)
+
+// reservedWords include the client attribute of the generated class, which a flag accessor must not shadow.
+var reservedWords = generators.PythonReservedWords.With("client")
+
 //go:embed python.tmpl
 var pythonTmpl string
 
+//go:embed python.pyi.tmpl
+var pythonStubTmpl string
+
+//go:embed python.testing.tmpl
+var pythonTestingTmpl string
+
 func openFeatureType(t flagset.FlagType) string {
 	switch t {
 	case flagset.IntType:
@@ -74,6 +99,19 @@ func pythonBoolLiteral(value interface{}) interface{} {
 	return value
 }
 
+var formatLiteral = generators.DefaultValueFormatter(generators.QuotePython)
+
+// formatDefaultValue returns the Python literal of the default value of the flag
+func formatDefaultValue(flag flagset.Flag) string {
+	if flag.Type == flagset.BoolType {
+		if flag.DefaultValue == true {
+			return "True"
+		}
+		return "False"
+	}
+	return formatLiteral(flag)
+}
+
 func (g *PythonGenerator) Generate(params *generators.Params[Params]) error {
 	funcs := template.FuncMap{
 		"OpenFeatureType":         openFeatureType,
@@ -82,14 +120,55 @@ func (g *PythonGenerator) Generate(params *generators.Params[Params]) error {
 		"TypedDetailsMethodSync":  typedDetailsMethodSync,
 		"TypedDetailsMethodAsync": typedDetailsMethodAsync,
 		"PythonBoolLiteral":       pythonBoolLiteral,
+		"FormatDefaultValue":      formatDefaultValue,
+		"API": func() string {
+			return params.Custom.API
+		},
+	}
+
+	// A module named openfeature would shadow the OpenFeature SDK that the generated code imports
+	if strings.Split(params.Custom.ModuleName, ".")[0] == "openfeature" {
+		return fmt.Errorf("invalid module name %q, it shadows the openfeature SDK package", params.Custom.ModuleName)
+	}
+
+	switch params.Custom.API {
+	case APIBoth, APISync, APIAsync:
+	default:
+		return fmt.Errorf("invalid API %q, must be one of %q, %q or %q", params.Custom.API, APIBoth, APISync, APIAsync)
 	}
 
 	newParams := &generators.Params[any]{
 		OutputPath: params.OutputPath,
-		Custom:     Params{},
+		Custom:     params.Custom,
+	}
+
+	moduleName := params.Custom.ModuleName
+	clientFile := moduleName + ".py"
+	testingFile := moduleName + "_testing.py"
+	if params.Custom.Package {
+		clientFile = moduleName + "/__init__.py"
+		testingFile = moduleName + "/testing.py"
 	}
 
-	return g.GenerateFile(funcs, pythonTmpl, newParams, "openfeature.py")
+	if err := g.GenerateFile(funcs, pythonTmpl, newParams, clientFile); err != nil {
+		return err
+	}
+
+	if params.Custom.Package {
+		if err := g.GenerateFile(funcs, pythonStubTmpl, newParams, moduleName+"/__init__.pyi"); err != nil {
+			return err
+		}
+		// PEP 561 marker, so that type checkers pick up the inline types and the stub
+		if err := g.GenerateFile(funcs, "", newParams, moduleName+"/py.typed"); err != nil {
+			return err
+		}
+	}
+
+	if !params.Custom.TestHelpers {
+		return nil
+	}
+
+	return g.GenerateFile(funcs, pythonTestingTmpl, newParams, testingFile)
 }
 
 // NewGenerator creates a generator for Python.
@@ -97,6 +176,16 @@ func NewGenerator(fs *flagset.Flagset) *PythonGenerator {
 	return &PythonGenerator{
 		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{
 			flagset.ObjectType: true,
-		}),
+		}, reservedWords),
 	}
 }
+
+// Accessors returns the names of the generated accessors of the flags, e.g. enable_feature_a.
+func Accessors(fs *flagset.Flagset) map[string]string {
+	return NewGenerator(fs).Accessors(func(flag flagset.Flag, id generators.Identifiers) string {
+		if flag.Group != "" {
+			return id.Snake(flag.Group) + "." + id.Snake(flag.Name())
+		}
+		return id.Snake(flag.Key)
+	})
+}
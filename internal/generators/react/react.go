@@ -13,29 +13,20 @@ type ReactGenerator struct {
 }
 
 type Params struct {
+	TestHelpers bool
 }
 
 //go:embed react.tmpl
 var reactTmpl string
 
-func openFeatureType(t flagset.FlagType) string {
-	switch t {
-	case flagset.IntType:
-		fallthrough
-	case flagset.FloatType:
-		return "number"
-	case flagset.BoolType:
-		return "boolean"
-	case flagset.StringType:
-		return "string"
-	default:
-		return ""
-	}
-}
+//go:embed react.testing.tmpl
+var reactTestingTmpl string
 
 func (g *ReactGenerator) Generate(params *generators.Params[Params]) error {
 	funcs := template.FuncMap{
-		"OpenFeatureType": openFeatureType,
+		"OpenFeatureType":    generators.TypeScriptType,
+		"FormatDefaultValue": generators.DefaultValueFormatter(generators.QuoteJavaScript),
+		"PropertyName":       generators.JavaScriptPropertyName,
 	}
 
 	newParams := &generators.Params[any]{
@@ -43,7 +34,15 @@ func (g *ReactGenerator) Generate(params *generators.Params[Params]) error {
 		Custom:     Params{},
 	}
 
-	return g.GenerateFile(funcs, reactTmpl, newParams, "openfeature.ts")
+	if err := g.GenerateFile(funcs, reactTmpl, newParams, "openfeature.ts"); err != nil {
+		return err
+	}
+
+	if !params.Custom.TestHelpers {
+		return nil
+	}
+
+	return g.GenerateFile(funcs, reactTestingTmpl, newParams, "openfeature.testing.tsx")
 }
 
 // NewGenerator creates a generator for React.
@@ -51,6 +50,13 @@ func NewGenerator(fs *flagset.Flagset) *ReactGenerator {
 	return &ReactGenerator{
 		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{
 			flagset.ObjectType: true,
-		}),
+		}, generators.JavaScriptReservedWords),
 	}
 }
+
+// Accessors returns the names of the generated accessors of the flags, e.g. useEnableFeatureA.
+func Accessors(fs *flagset.Flagset) map[string]string {
+	return NewGenerator(fs).Accessors(func(flag flagset.Flag, id generators.Identifiers) string {
+		return "use" + id.Pascal(flag.Key)
+	})
+}
@@ -0,0 +1,94 @@
+package ruby
+
+import (
+	_ "embed"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+)
+
+type RubyGenerator struct {
+	generators.CommonGenerator
+}
+
+type Params struct {
+	// ModuleName is the name of the generated Ruby module
+	ModuleName string
+}
+
+// reservedWords include the client accessors of the generated module, which a flag accessor must not replace.
+var reservedWords = generators.RubyReservedWords.With("client")
+
+//go:embed ruby.tmpl
+var rubyTmpl string
+
+//go:embed ruby.rbs.tmpl
+var rbsTmpl string
+
+func openFeatureType(t flagset.FlagType) string {
+	switch t {
+	case flagset.IntType:
+		return "Integer"
+	case flagset.FloatType:
+		return "Float"
+	case flagset.BoolType:
+		return "bool"
+	case flagset.StringType:
+		return "String"
+	default:
+		return ""
+	}
+}
+
+func methodType(t flagset.FlagType) string {
+	switch t {
+	case flagset.IntType:
+		return "integer"
+	case flagset.FloatType:
+		return "float"
+	case flagset.BoolType:
+		return "boolean"
+	case flagset.StringType:
+		return "string"
+	default:
+		return ""
+	}
+}
+
+func (g *RubyGenerator) Generate(params *generators.Params[Params]) error {
+	funcs := template.FuncMap{
+		"OpenFeatureType":    openFeatureType,
+		"MethodType":         methodType,
+		"FormatDefaultValue": generators.DefaultValueFormatter(generators.QuoteRuby),
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath: params.OutputPath,
+		Custom:     params.Custom,
+	}
+
+	fileName := strcase.ToSnake(params.Custom.ModuleName)
+	if err := g.GenerateFile(funcs, rubyTmpl, newParams, fileName+".rb"); err != nil {
+		return err
+	}
+
+	return g.GenerateFile(funcs, rbsTmpl, newParams, "sig/"+fileName+".rbs")
+}
+
+// NewGenerator creates a generator for Ruby.
+func NewGenerator(fs *flagset.Flagset) *RubyGenerator {
+	return &RubyGenerator{
+		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{
+			flagset.ObjectType: true,
+		}, reservedWords),
+	}
+}
+
+// Accessors returns the names of the generated accessors of the flags, e.g. enable_feature_a.
+func Accessors(fs *flagset.Flagset) map[string]string {
+	return NewGenerator(fs).Accessors(func(flag flagset.Flag, id generators.Identifiers) string {
+		return id.Snake(flag.Key)
+	})
+}
@@ -0,0 +1,49 @@
+package svelte
+
+import (
+	_ "embed"
+	"text/template"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+)
+
+type SvelteGenerator struct {
+	generators.CommonGenerator
+}
+
+type Params struct {
+}
+
+//go:embed svelte.tmpl
+var svelteTmpl string
+
+func (g *SvelteGenerator) Generate(params *generators.Params[Params]) error {
+	funcs := template.FuncMap{
+		"OpenFeatureType":    generators.TypeScriptType,
+		"FormatDefaultValue": generators.DefaultValueFormatter(generators.QuoteJavaScript),
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath: params.OutputPath,
+		Custom:     Params{},
+	}
+
+	return g.GenerateFile(funcs, svelteTmpl, newParams, "openfeature.ts")
+}
+
+// NewGenerator creates a generator for Svelte.
+func NewGenerator(fs *flagset.Flagset) *SvelteGenerator {
+	return &SvelteGenerator{
+		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{
+			flagset.ObjectType: true,
+		}, generators.JavaScriptReservedWords),
+	}
+}
+
+// Accessors returns the names of the generated accessors of the flags, e.g. enableFeatureA.
+func Accessors(fs *flagset.Flagset) map[string]string {
+	return NewGenerator(fs).Accessors(func(flag flagset.Flag, id generators.Identifiers) string {
+		return id.Camel(flag.Key)
+	})
+}
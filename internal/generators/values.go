@@ -0,0 +1,53 @@
+package generators
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// DefaultValueFormatter returns a template function formatting the default value of a flag as a literal,
+// using quote for the string literals of the target language.
+// The literals of whole floats keep a decimal point, e.g. 1.0, as integer literals aren't
+// accepted where a float is expected in every language.
+func DefaultValueFormatter(quote func(string) string) func(flagset.Flag) string {
+	return func(flag flagset.Flag) string {
+		switch flag.Type {
+		case flagset.StringType:
+			return quote(fmt.Sprintf("%v", flag.DefaultValue))
+		case flagset.BoolType:
+			if flag.DefaultValue == true {
+				return "true"
+			}
+			return "false"
+		case flagset.FloatType:
+			if v, ok := flag.DefaultValue.(float64); ok {
+				s := strconv.FormatFloat(v, 'f', -1, 64)
+				if !strings.Contains(s, ".") {
+					s += ".0"
+				}
+				return s
+			}
+		}
+		return fmt.Sprintf("%v", flag.DefaultValue)
+	}
+}
+
+// TypeScriptType returns the TypeScript type of the values of a flag type,
+// shared by the generators of the JavaScript SDKs.
+func TypeScriptType(t flagset.FlagType) string {
+	switch t {
+	case flagset.IntType:
+		fallthrough
+	case flagset.FloatType:
+		return "number"
+	case flagset.BoolType:
+		return "boolean"
+	case flagset.StringType:
+		return "string"
+	default:
+		return ""
+	}
+}
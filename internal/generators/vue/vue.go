@@ -0,0 +1,49 @@
+package vue
+
+import (
+	_ "embed"
+	"text/template"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+)
+
+type VueGenerator struct {
+	generators.CommonGenerator
+}
+
+type Params struct {
+}
+
+//go:embed vue.tmpl
+var vueTmpl string
+
+func (g *VueGenerator) Generate(params *generators.Params[Params]) error {
+	funcs := template.FuncMap{
+		"OpenFeatureType":    generators.TypeScriptType,
+		"FormatDefaultValue": generators.DefaultValueFormatter(generators.QuoteJavaScript),
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath: params.OutputPath,
+		Custom:     Params{},
+	}
+
+	return g.GenerateFile(funcs, vueTmpl, newParams, "openfeature.ts")
+}
+
+// NewGenerator creates a generator for Vue.
+func NewGenerator(fs *flagset.Flagset) *VueGenerator {
+	return &VueGenerator{
+		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{
+			flagset.ObjectType: true,
+		}, generators.JavaScriptReservedWords),
+	}
+}
+
+// Accessors returns the names of the generated accessors of the flags, e.g. useEnableFeatureA.
+func Accessors(fs *flagset.Flagset) map[string]string {
+	return NewGenerator(fs).Accessors(func(flag flagset.Flag, id generators.Identifiers) string {
+		return "use" + id.Pascal(flag.Key)
+	})
+}
@@ -0,0 +1,49 @@
+package web
+
+import (
+	_ "embed"
+	"text/template"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+)
+
+type WebGenerator struct {
+	generators.CommonGenerator
+}
+
+type Params struct {
+}
+
+//go:embed web.tmpl
+var webTmpl string
+
+func (g *WebGenerator) Generate(params *generators.Params[Params]) error {
+	funcs := template.FuncMap{
+		"OpenFeatureType":    generators.TypeScriptType,
+		"FormatDefaultValue": generators.DefaultValueFormatter(generators.QuoteJavaScript),
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath: params.OutputPath,
+		Custom:     Params{},
+	}
+
+	return g.GenerateFile(funcs, webTmpl, newParams, "openfeature.ts")
+}
+
+// NewGenerator creates a generator for the Web SDK.
+func NewGenerator(fs *flagset.Flagset) *WebGenerator {
+	return &WebGenerator{
+		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{
+			flagset.ObjectType: true,
+		}, generators.JavaScriptReservedWords),
+	}
+}
+
+// Accessors returns the names of the generated accessors of the flags, e.g. enableFeatureA.
+func Accessors(fs *flagset.Flagset) map[string]string {
+	return NewGenerator(fs).Accessors(func(flag flagset.Flag, id generators.Identifiers) string {
+		return id.Camel(flag.Key)
+	})
+}
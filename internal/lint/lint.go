@@ -0,0 +1,259 @@
+// Package lint checks flag manifests against conventions that the JSON schema can't express,
+// such as naming conventions or required descriptions.
+package lint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/open-feature/cli/internal/manifest"
+)
+
+// Severity is the severity of the issues a rule reports.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	// SeverityOff disables a rule.
+	SeverityOff Severity = "off"
+)
+
+// SuppressionKey is the metadata field of a flag listing the rules that are not applied to the flag.
+const SuppressionKey = "lintIgnore"
+
+// Issue is a violation of a rule.
+type Issue struct {
+	Rule     string   `json:"rule" yaml:"rule"`
+	Severity Severity `json:"severity" yaml:"severity"`
+	// Flag is the key of the flag, empty for issues of the whole manifest
+	Flag    string `json:"flag,omitempty" yaml:"flag,omitempty"`
+	Message string `json:"message" yaml:"message"`
+	// Source is the manifest file the flag is defined in, set for manifests that include other manifests
+	Source string `json:"source,omitempty" yaml:"source,omitempty"`
+}
+
+// Finding is a violation found by a rule, which gets its severity from the configuration.
+type Finding struct {
+	// Flag is the key of the flag, empty for findings of the whole manifest
+	Flag    string
+	Message string
+}
+
+// Rule checks a manifest against a convention.
+type Rule interface {
+	Check(m *manifest.Manifest) []Finding
+}
+
+// RuleFunc is a function implementing Rule.
+type RuleFunc func(m *manifest.Manifest) []Finding
+
+func (f RuleFunc) Check(m *manifest.Manifest) []Finding {
+	return f(m)
+}
+
+// RuleInfo describes a rule and creates it from its options.
+type RuleInfo struct {
+	Name        string
+	Description string
+	// Severity is the default severity of the rule
+	Severity Severity
+	// Enabled rules run without being configured, other rules run once they are configured
+	Enabled bool
+	// Options are the names of the options of the rule
+	Options []string
+	New     func(options Options) (Rule, error)
+}
+
+// RuleConfig configures a rule: its severity, and the options of the rule.
+type RuleConfig map[string]any
+
+// Config configures the rules, keyed by the name of the rule.
+type Config map[string]RuleConfig
+
+// Registry maintains the available rules.
+type Registry struct {
+	rules map[string]RuleInfo
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		rules: make(map[string]RuleInfo),
+	}
+}
+
+// DefaultRegistry contains the built-in rules.
+var DefaultRegistry = NewRegistry()
+
+// Register adds a rule to the registry.
+func (r *Registry) Register(info RuleInfo) {
+	r.rules[info.Name] = info
+}
+
+// GetAll returns the registered rules sorted by name.
+func (r *Registry) GetAll() []RuleInfo {
+	rules := make([]RuleInfo, 0, len(r.rules))
+	for _, info := range r.rules {
+		rules = append(rules, info)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Name < rules[j].Name
+	})
+	return rules
+}
+
+// Run checks the manifest against the enabled rules and returns the issues sorted by flag and rule.
+// Issues of flags suppressing the rule in their metadata are left out.
+func (r *Registry) Run(m *manifest.Manifest, config Config) ([]Issue, error) {
+	for name := range config {
+		if _, ok := r.rules[name]; !ok {
+			return nil, fmt.Errorf("unknown lint rule %q", name)
+		}
+	}
+
+	var issues []Issue
+	for _, info := range r.GetAll() {
+		ruleConfig, configured := config[info.Name]
+		if !info.Enabled && !configured {
+			continue
+		}
+		severity, options, err := parseRuleConfig(info, ruleConfig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid configuration of lint rule %q: %w", info.Name, err)
+		}
+		if severity == SeverityOff {
+			continue
+		}
+		rule, err := info.New(options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid configuration of lint rule %q: %w", info.Name, err)
+		}
+
+		for _, finding := range rule.Check(m) {
+			if finding.Flag != "" && suppressed(m.Flags[finding.Flag], info.Name) {
+				continue
+			}
+			issues = append(issues, Issue{
+				Rule:     info.Name,
+				Severity: severity,
+				Flag:     finding.Flag,
+				Message:  finding.Message,
+				Source:   m.Sources[finding.Flag],
+			})
+		}
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Flag != issues[j].Flag {
+			return issues[i].Flag < issues[j].Flag
+		}
+		return issues[i].Rule < issues[j].Rule
+	})
+	return issues, nil
+}
+
+func parseRuleConfig(info RuleInfo, config RuleConfig) (Severity, Options, error) {
+	severity := info.Severity
+	options := make(Options, len(config))
+	for key, value := range config {
+		if key == "severity" {
+			s, _ := value.(string)
+			switch Severity(s) {
+			case SeverityError, SeverityWarning, SeverityOff:
+				severity = Severity(s)
+			default:
+				return "", nil, fmt.Errorf("invalid severity %v, valid severities are: error, warning, off", value)
+			}
+			continue
+		}
+		known := false
+		for _, option := range info.Options {
+			known = known || option == key
+		}
+		if !known {
+			return "", nil, fmt.Errorf("unknown option %q", key)
+		}
+		options[key] = value
+	}
+	return severity, options, nil
+}
+
+// suppressed returns whether the metadata of the flag suppresses the rule.
+func suppressed(flag any, rule string) bool {
+	ignored, _ := manifest.Metadata(flag)[SuppressionKey].([]any)
+	for _, name := range ignored {
+		if name == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// HasErrors returns whether any of the issues has the error severity.
+func HasErrors(issues []Issue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Options are the options of a rule from the configuration.
+type Options map[string]any
+
+// String returns the string option, or the fallback if it isn't set.
+func (o Options) String(name, fallback string) (string, error) {
+	value, ok := o[name]
+	if !ok {
+		return fallback, nil
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("option %q must be a string", name)
+	}
+	return s, nil
+}
+
+// Int returns the integer option, or the fallback if it isn't set.
+func (o Options) Int(name string, fallback int) (int, error) {
+	value, ok := o[name]
+	if !ok {
+		return fallback, nil
+	}
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case int64:
+		return int(v), nil
+	case float64:
+		if v == float64(int(v)) {
+			return int(v), nil
+		}
+	}
+	return 0, fmt.Errorf("option %q must be an integer", name)
+}
+
+// Strings returns the list option, or nil if it isn't set.
+func (o Options) Strings(name string) ([]string, error) {
+	value, ok := o[name]
+	if !ok {
+		return nil, nil
+	}
+	switch v := value.(type) {
+	case []string:
+		return v, nil
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("option %q must be a list of strings", name)
+			}
+			values = append(values, s)
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("option %q must be a list of strings", name)
+}
@@ -0,0 +1,100 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/open-feature/cli/internal/manifest"
+)
+
+func testManifest() *manifest.Manifest {
+	return &manifest.Manifest{
+		Flags: map[string]any{
+			"enableCheckout": map[string]any{
+				"flagType":     "boolean",
+				"defaultValue": false,
+				"description":  "Enables the new checkout flow",
+				"metadata":     map[string]any{"owner": "payments"},
+			},
+			"tmp_banner": map[string]any{
+				"flagType":     "string",
+				"defaultValue": "",
+				"description":  "Banner",
+				"owner":        "marketing",
+			},
+			"checkout.newFlow": map[string]any{
+				"flagType":     "boolean",
+				"defaultValue": true,
+				"group":        "checkout",
+				"metadata":     map[string]any{"lintIgnore": []any{"description"}},
+			},
+		},
+	}
+}
+
+func TestRun(t *testing.T) {
+	config := Config{
+		"description":       {"min-length": 10},
+		"banned-prefixes":   {"prefixes": []any{"tmp"}},
+		"required-metadata": {"severity": "warning", "fields": []any{"owner"}},
+		"max-flags":         {"max": 2},
+	}
+
+	issues, err := DefaultRegistry.Run(testManifest(), config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []Issue{
+		{Rule: "max-flags", Severity: SeverityError, Message: "manifest defines 3 flags, more than the maximum of 2"},
+		{Rule: "required-metadata", Severity: SeverityWarning, Flag: "checkout.newFlow", Message: `flag has no metadata field "owner"`},
+		{Rule: "banned-prefixes", Severity: SeverityError, Flag: "tmp_banner", Message: `key starts with the banned prefix "tmp"`},
+		{Rule: "description", Severity: SeverityWarning, Flag: "tmp_banner", Message: "description is shorter than 10 characters"},
+		{Rule: "key-naming", Severity: SeverityWarning, Flag: "tmp_banner", Message: "key is not in camel case"},
+	}
+	if diff := cmp.Diff(expected, issues); diff != "" {
+		t.Errorf("unexpected issues (-want +got):\n%s", diff)
+	}
+	if !HasErrors(issues) {
+		t.Error("expected the issues to have errors")
+	}
+}
+
+func TestRunKeyNamingPattern(t *testing.T) {
+	config := Config{
+		"description": {"severity": "off"},
+		"key-naming":  {"pattern": `^[a-z][a-zA-Z.]*$`},
+	}
+
+	issues, err := DefaultRegistry.Run(testManifest(), config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Flag != "tmp_banner" {
+		t.Errorf("expected only tmp_banner to violate the pattern, got %v", issues)
+	}
+}
+
+func TestRunInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		err    string
+	}{
+		{name: "unknown rule", config: Config{"unknown": nil}, err: `unknown lint rule "unknown"`},
+		{name: "unknown option", config: Config{"description": {"max": 1}}, err: `unknown option "max"`},
+		{name: "invalid severity", config: Config{"description": {"severity": "fatal"}}, err: "invalid severity fatal"},
+		{name: "invalid convention", config: Config{"key-naming": {"convention": "title"}}, err: `unknown convention "title"`},
+		{name: "missing option", config: Config{"required-metadata": nil}, err: `option "fields" is required`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DefaultRegistry.Run(testManifest(), tt.config)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("expected error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
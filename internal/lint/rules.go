@@ -0,0 +1,195 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/open-feature/cli/internal/manifest"
+)
+
+// conventions are the regular expressions of the naming conventions of flag keys.
+var conventions = map[string]*regexp.Regexp{
+	"camel":           regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`),
+	"pascal":          regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`),
+	"kebab":           regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`),
+	"snake":           regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`),
+	"screaming-snake": regexp.MustCompile(`^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$`),
+}
+
+func init() {
+	DefaultRegistry.Register(RuleInfo{
+		Name:        "key-naming",
+		Description: "Flag keys follow a naming convention (camel, pascal, kebab, snake or screaming-snake) or match a pattern",
+		Severity:    SeverityWarning,
+		Enabled:     true,
+		Options:     []string{"convention", "pattern"},
+		New:         newKeyNamingRule,
+	})
+	DefaultRegistry.Register(RuleInfo{
+		Name:        "description",
+		Description: "Flags have a description of a minimum length",
+		Severity:    SeverityWarning,
+		Enabled:     true,
+		Options:     []string{"min-length"},
+		New:         newDescriptionRule,
+	})
+	DefaultRegistry.Register(RuleInfo{
+		Name:        "max-flags",
+		Description: "The manifest defines at most a maximum number of flags",
+		Severity:    SeverityError,
+		Options:     []string{"max"},
+		New:         newMaxFlagsRule,
+	})
+	DefaultRegistry.Register(RuleInfo{
+		Name:        "banned-prefixes",
+		Description: "Flag keys don't start with any of the banned prefixes",
+		Severity:    SeverityError,
+		Options:     []string{"prefixes"},
+		New:         newBannedPrefixesRule,
+	})
+	DefaultRegistry.Register(RuleInfo{
+		Name:        "required-metadata",
+		Description: "Flags have all of the required metadata fields, e.g. an owner",
+		Severity:    SeverityError,
+		Options:     []string{"fields"},
+		New:         newRequiredMetadataRule,
+	})
+}
+
+// sortedKeys returns the flag keys of the manifest in a stable order.
+func sortedKeys(m *manifest.Manifest) []string {
+	keys := make([]string, 0, len(m.Flags))
+	for key := range m.Flags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func newKeyNamingRule(options Options) (Rule, error) {
+	pattern, err := options.String("pattern", "")
+	if err != nil {
+		return nil, err
+	}
+	if pattern != "" {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern: %w", err)
+		}
+		return RuleFunc(func(m *manifest.Manifest) []Finding {
+			var findings []Finding
+			for _, key := range sortedKeys(m) {
+				if !re.MatchString(key) {
+					findings = append(findings, Finding{Flag: key, Message: fmt.Sprintf("key does not match the pattern %q", pattern)})
+				}
+			}
+			return findings
+		}), nil
+	}
+
+	convention, err := options.String("convention", "camel")
+	if err != nil {
+		return nil, err
+	}
+	re, ok := conventions[convention]
+	if !ok {
+		return nil, fmt.Errorf("unknown convention %q, valid conventions are: camel, pascal, kebab, snake, screaming-snake", convention)
+	}
+	return RuleFunc(func(m *manifest.Manifest) []Finding {
+		var findings []Finding
+		for _, key := range sortedKeys(m) {
+			// The segments of grouped keys, e.g. checkout.newFlow, follow the convention on their own.
+			for _, segment := range strings.Split(key, ".") {
+				if !re.MatchString(segment) {
+					findings = append(findings, Finding{Flag: key, Message: fmt.Sprintf("key is not in %s case", convention)})
+					break
+				}
+			}
+		}
+		return findings
+	}), nil
+}
+
+func newDescriptionRule(options Options) (Rule, error) {
+	minLength, err := options.Int("min-length", 1)
+	if err != nil {
+		return nil, err
+	}
+	return RuleFunc(func(m *manifest.Manifest) []Finding {
+		var findings []Finding
+		for _, key := range sortedKeys(m) {
+			flag, _ := m.Flags[key].(map[string]any)
+			description, _ := flag["description"].(string)
+			description = strings.TrimSpace(description)
+			switch {
+			case description == "":
+				findings = append(findings, Finding{Flag: key, Message: "flag has no description"})
+			case len([]rune(description)) < minLength:
+				findings = append(findings, Finding{Flag: key, Message: fmt.Sprintf("description is shorter than %d characters", minLength)})
+			}
+		}
+		return findings
+	}), nil
+}
+
+func newMaxFlagsRule(options Options) (Rule, error) {
+	max, err := options.Int("max", 0)
+	if err != nil {
+		return nil, err
+	}
+	if max <= 0 {
+		return nil, fmt.Errorf("option %q must be a positive integer", "max")
+	}
+	return RuleFunc(func(m *manifest.Manifest) []Finding {
+		if len(m.Flags) <= max {
+			return nil
+		}
+		return []Finding{{Message: fmt.Sprintf("manifest defines %d flags, more than the maximum of %d", len(m.Flags), max)}}
+	}), nil
+}
+
+func newBannedPrefixesRule(options Options) (Rule, error) {
+	prefixes, err := options.Strings("prefixes")
+	if err != nil {
+		return nil, err
+	}
+	if len(prefixes) == 0 {
+		return nil, fmt.Errorf("option %q is required", "prefixes")
+	}
+	return RuleFunc(func(m *manifest.Manifest) []Finding {
+		var findings []Finding
+		for _, key := range sortedKeys(m) {
+			for _, prefix := range prefixes {
+				if strings.HasPrefix(key, prefix) {
+					findings = append(findings, Finding{Flag: key, Message: fmt.Sprintf("key starts with the banned prefix %q", prefix)})
+					break
+				}
+			}
+		}
+		return findings
+	}), nil
+}
+
+func newRequiredMetadataRule(options Options) (Rule, error) {
+	fields, err := options.Strings("fields")
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("option %q is required", "fields")
+	}
+	return RuleFunc(func(m *manifest.Manifest) []Finding {
+		var findings []Finding
+		for _, key := range sortedKeys(m) {
+			metadata := manifest.Metadata(m.Flags[key])
+			for _, field := range fields {
+				if value, ok := metadata[field]; !ok || value == "" {
+					findings = append(findings, Finding{Flag: key, Message: fmt.Sprintf("flag has no metadata field %q", field)})
+				}
+			}
+		}
+		return findings
+	}), nil
+}
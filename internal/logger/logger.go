@@ -26,6 +26,8 @@ type Logger interface {
 	IsDebugEnabled() bool
 	// FileCreated logs a file creation event
 	FileCreated(path string)
+	// FileUpdated logs a file update event
+	FileUpdated(path string)
 	// FileFailed logs a file creation failure
 	FileFailed(path string, err error)
 	// GenerationStarted logs the start of a generation process
@@ -97,6 +99,12 @@ func (l *DefaultLogger) FileCreated(path string) {
 	pterm.Success.Printf("Created %s\n", prettyPath)
 }
 
+// FileUpdated logs a file update event
+func (l *DefaultLogger) FileUpdated(path string) {
+	prettyPath := pterm.LightWhite(filepath.Clean(path))
+	pterm.Success.Printf("Updated %s\n", prettyPath)
+}
+
 // FileFailed logs a file creation failure
 func (l *DefaultLogger) FileFailed(path string, err error) {
 	prettyPath := pterm.LightWhite(filepath.Clean(path))
@@ -10,6 +10,8 @@ type Change struct {
 	Path     string `json:"path"`
 	OldValue any    `json:"oldValue,omitempty"`
 	NewValue any    `json:"newValue,omitempty"`
+	// Source is the manifest file the flag is defined in, set for manifests that include other manifests
+	Source string `json:"source,omitempty" yaml:"source,omitempty"`
 }
 
 func Compare(oldManifest, newManifest *Manifest) ([]Change, error) {
@@ -25,6 +27,7 @@ func Compare(oldManifest, newManifest *Manifest) ([]Change, error) {
 					Path:     fmt.Sprintf("flags.%s", key),
 					OldValue: oldFlag,
 					NewValue: newFlag,
+					Source:   newManifest.Sources[key],
 				})
 			}
 		} else {
@@ -32,6 +35,7 @@ func Compare(oldManifest, newManifest *Manifest) ([]Change, error) {
 				Type:     "add",
 				Path:     fmt.Sprintf("flags.%s", key),
 				NewValue: newFlag,
+				Source:   newManifest.Sources[key],
 			})
 		}
 	}
@@ -42,6 +46,7 @@ func Compare(oldManifest, newManifest *Manifest) ([]Change, error) {
 				Type:     "remove",
 				Path:     fmt.Sprintf("flags.%s", key),
 				OldValue: oldFlag,
+				Source:   oldManifest.Sources[key],
 			})
 		}
 	}
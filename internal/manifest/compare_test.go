@@ -63,3 +63,31 @@ func sortChanges(changes []Change) {
 		return changes[i].Path < changes[j].Path
 	})
 }
+
+func TestCompareReportsSources(t *testing.T) {
+	oldManifest := &Manifest{
+		Flags:   map[string]any{"removed": "value"},
+		Sources: map[string]string{"removed": "teams/old.json"},
+	}
+	newManifest := &Manifest{
+		Flags:   map[string]any{"added": "value"},
+		Sources: map[string]string{"added": "teams/new.json"},
+	}
+
+	changes, err := Compare(oldManifest, newManifest)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedChanges := []Change{
+		{Type: "add", Path: "flags.added", NewValue: "value", Source: "teams/new.json"},
+		{Type: "remove", Path: "flags.removed", OldValue: "value", Source: "teams/old.json"},
+	}
+
+	sortChanges(changes)
+	sortChanges(expectedChanges)
+
+	if !reflect.DeepEqual(changes, expectedChanges) {
+		t.Errorf("expected %v, got %v", expectedChanges, changes)
+	}
+}
@@ -0,0 +1,304 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FormatOptions configure the canonical form of manifests.
+type FormatOptions struct {
+	// Indent is the number of spaces to indent by
+	Indent int
+	// SortKeys sorts flags and free-form objects by key instead of preserving their order
+	SortKeys bool
+}
+
+// shape describes the properties of an object in the manifest, which are put into a canonical order.
+// Objects without a shape, such as default values and metadata, are free-form.
+type shape struct {
+	// order is the canonical order of the known properties
+	order []string
+	// fields are the shapes of the values of the known properties
+	fields map[string]*shape
+	// values is the shape of all values of objects keyed by name, such as flags
+	values *shape
+}
+
+var manifestShape = &shape{
+	order: []string{"$schema", "version", "includes", "context", "flags", "environments"},
+	fields: map[string]*shape{
+		"context":      {values: &shape{order: []string{"type", "required", "description"}}},
+		"flags":        {values: &shape{order: []string{"flagType", "defaultValue", "description", "group", "metadata"}}},
+		"environments": {values: &shape{values: &shape{order: []string{"defaultValue"}}}},
+	},
+}
+
+// IsYAML reports whether the manifest file at the given path is a YAML file.
+func IsYAML(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// Format rewrites the manifest file at the given path into its canonical form:
+// a normalized $schema, the properties of flags in the order flagType, defaultValue, description,
+// and a consistent indentation. JSON and YAML files keep their format, and YAML files keep their comments.
+func Format(path string, data []byte, options FormatOptions) ([]byte, error) {
+	if options.Indent < 1 {
+		return nil, fmt.Errorf("invalid indentation %d", options.Indent)
+	}
+
+	var doc, root *yaml.Node
+	if IsYAML(path) {
+		doc = &yaml.Node{}
+		if err := yaml.Unmarshal(data, doc); err != nil {
+			return nil, fmt.Errorf("error unmarshaling YAML: %w", err)
+		}
+		if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+			return nil, errors.New("manifest must be an object")
+		}
+		root = doc.Content[0]
+	} else {
+		value, err := decodeOrdered(data)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling JSON: %w", err)
+		}
+		root = valueNode(value)
+	}
+	if root.Kind != yaml.MappingNode {
+		return nil, errors.New("manifest must be an object")
+	}
+
+	jsonData, err := nodeJSON(root, "  ")
+	if err != nil {
+		return nil, err
+	}
+	version, err := Version(jsonData)
+	if err != nil {
+		return nil, err
+	}
+	normalizeSchema(root, version)
+	orderNode(root, manifestShape, options.SortKeys)
+
+	if !IsYAML(path) {
+		return nodeJSON(root, strings.Repeat(" ", options.Indent))
+	}
+	return encodeYAML(doc, options.Indent)
+}
+
+// normalizeSchema points the $schema of the manifest to the canonical URL of the schema of its version.
+// URLs of other schemas and local paths are kept.
+func normalizeSchema(root *yaml.Node, version string) {
+	for i := 0; i < len(root.Content); i += 2 {
+		if root.Content[i].Value != "$schema" {
+			continue
+		}
+		value := root.Content[i+1]
+		url := value.Value
+		if url == "" || strings.HasPrefix(url, "http") && schemaVersionPattern.MatchString(url) {
+			value.Kind, value.Tag, value.Value = yaml.ScalarNode, "!!str", SchemaURL(version)
+		}
+		return
+	}
+	root.Content = append([]*yaml.Node{
+		{Kind: yaml.ScalarNode, Tag: "!!str", Value: "$schema"},
+		{Kind: yaml.ScalarNode, Tag: "!!str", Value: SchemaURL(version)},
+	}, root.Content...)
+}
+
+// orderNode puts the properties of the objects in the node into their canonical order.
+// Properties without a canonical order are sorted by key if sortKeys is set and kept in their order otherwise.
+func orderNode(node *yaml.Node, s *shape, sortKeys bool) {
+	switch node.Kind {
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			orderNode(item, nil, sortKeys)
+		}
+	case yaml.MappingNode:
+		type pair struct {
+			key, value *yaml.Node
+		}
+		pairs := make([]pair, 0, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			pairs = append(pairs, pair{key: node.Content[i], value: node.Content[i+1]})
+		}
+
+		var order []string
+		if s != nil {
+			order = s.order
+		}
+		rank := func(key string) int {
+			for i, name := range order {
+				if name == key {
+					return i
+				}
+			}
+			return len(order)
+		}
+		sort.SliceStable(pairs, func(i, j int) bool {
+			ri, rj := rank(pairs[i].key.Value), rank(pairs[j].key.Value)
+			if ri != rj {
+				return ri < rj
+			}
+			return sortKeys && ri == len(order) && pairs[i].key.Value < pairs[j].key.Value
+		})
+
+		node.Content = node.Content[:0]
+		for _, p := range pairs {
+			node.Content = append(node.Content, p.key, p.value)
+			var child *shape
+			if s != nil {
+				if field, ok := s.fields[p.key.Value]; ok {
+					child = field
+				} else if s.values != nil {
+					child = s.values
+				}
+			}
+			orderNode(p.value, child, sortKeys)
+		}
+	}
+}
+
+// encodeYAML encodes the node as a YAML document in block style.
+func encodeYAML(root *yaml.Node, indent int) ([]byte, error) {
+	blockStyle(root)
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(indent)
+	if err := encoder.Encode(root); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// blockStyle turns the flow style objects and arrays of the node into block style.
+// Scalars keep their style, so that quoted strings stay quoted.
+func blockStyle(node *yaml.Node) {
+	if node.Kind == yaml.MappingNode || node.Kind == yaml.SequenceNode {
+		node.Style = 0
+	}
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+// valueNode converts a value decoded by decodeOrdered into a YAML node.
+func valueNode(value any) *yaml.Node {
+	switch v := value.(type) {
+	case *object:
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		for _, m := range v.members {
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: m.key}, valueNode(m.value))
+		}
+		return node
+	case []any:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for _, item := range v {
+			node.Content = append(node.Content, valueNode(item))
+		}
+		return node
+	case json.Number:
+		tag := "!!int"
+		if strings.ContainsAny(string(v), ".eE") {
+			tag = "!!float"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: string(v)}
+	case string:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v}
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(v)}
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+	}
+}
+
+// nodeJSON encodes the YAML node as JSON indented by the given string.
+func nodeJSON(node *yaml.Node, indent string) ([]byte, error) {
+	value, err := nodeValue(node)
+	if err != nil {
+		return nil, err
+	}
+	return encodeOrdered(value, indent)
+}
+
+// nodeValue converts a YAML node into the values decoded by decodeOrdered.
+// Numbers keep their literal, so that e.g. 1.50 isn't rewritten as 1.5.
+func nodeValue(node *yaml.Node) (any, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return nodeValue(node.Content[0])
+	case yaml.AliasNode:
+		return nodeValue(node.Alias)
+	case yaml.MappingNode:
+		o := &object{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			value, err := nodeValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			o.set(node.Content[i].Value, value)
+		}
+		return o, nil
+	case yaml.SequenceNode:
+		values := []any{}
+		for _, item := range node.Content {
+			value, err := nodeValue(item)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	}
+
+	switch node.ShortTag() {
+	case "!!null":
+		return nil, nil
+	case "!!bool":
+		var b bool
+		if err := node.Decode(&b); err != nil {
+			return nil, err
+		}
+		return b, nil
+	case "!!int", "!!float":
+		if isJSONNumber(node.Value) {
+			return json.Number(node.Value), nil
+		}
+		var f float64
+		if err := node.Decode(&f); err != nil {
+			return nil, err
+		}
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return nil, fmt.Errorf("the number %s on line %d cannot be represented in JSON", node.Value, node.Line)
+		}
+		if node.ShortTag() == "!!int" {
+			var i int64
+			if err := node.Decode(&i); err != nil {
+				return nil, err
+			}
+			return json.Number(strconv.FormatInt(i, 10)), nil
+		}
+		return json.Number(strconv.FormatFloat(f, 'g', -1, 64)), nil
+	default:
+		return node.Value, nil
+	}
+}
+
+func isJSONNumber(s string) bool {
+	var n json.Number
+	return s != "" && (s[0] == '-' || s[0] >= '0' && s[0] <= '9') && json.Unmarshal([]byte(s), &n) == nil
+}
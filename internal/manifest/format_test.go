@@ -0,0 +1,128 @@
+package manifest
+
+import (
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		options  FormatOptions
+		input    string
+		expected string
+	}{
+		{
+			name:    "json",
+			path:    "flags.json",
+			options: FormatOptions{Indent: 2},
+			input: `{
+	"flags": {
+		"zeta": {"description": "The <zeta> flag", "defaultValue": 1.50, "flagType": "float"},
+		"alpha": {"owner": "payments", "defaultValue": {"b": 1, "a": 2}, "flagType": "object"}
+	},
+	"$schema": "https://raw.githubusercontent.com/open-feature/cli/refs/heads/main/schema/v0/flag-manifest.json"
+}`,
+			expected: `{
+  "$schema": "https://raw.githubusercontent.com/open-feature/cli/main/schema/v0/flag-manifest.json",
+  "flags": {
+    "zeta": {
+      "flagType": "float",
+      "defaultValue": 1.50,
+      "description": "The <zeta> flag"
+    },
+    "alpha": {
+      "flagType": "object",
+      "defaultValue": {
+        "b": 1,
+        "a": 2
+      },
+      "owner": "payments"
+    }
+  }
+}
+`,
+		},
+		{
+			name:    "json with sorted keys",
+			path:    "flags.json",
+			options: FormatOptions{Indent: 4, SortKeys: true},
+			input:   `{"version": "v1", "flags": {"zeta": {"flagType": "boolean", "defaultValue": true, "metadata": {"team": "a", "owner": "b"}}, "alpha": {"flagType": "string", "defaultValue": ""}}}`,
+			expected: `{
+    "$schema": "https://raw.githubusercontent.com/open-feature/cli/main/schema/v1/flag-manifest.json",
+    "version": "v1",
+    "flags": {
+        "alpha": {
+            "flagType": "string",
+            "defaultValue": ""
+        },
+        "zeta": {
+            "flagType": "boolean",
+            "defaultValue": true,
+            "metadata": {
+                "owner": "b",
+                "team": "a"
+            }
+        }
+    }
+}
+`,
+		},
+		{
+			name:    "local schema",
+			path:    "flags.json",
+			options: FormatOptions{Indent: 2},
+			input:   `{"$schema": "../schema/v0/flag-manifest.json", "flags": {}}`,
+			expected: `{
+  "$schema": "../schema/v0/flag-manifest.json",
+  "flags": {}
+}
+`,
+		},
+		{
+			name:    "yaml",
+			path:    "flags.yaml",
+			options: FormatOptions{Indent: 2},
+			input: `# Flags of the checkout team
+flags:
+  enableCheckout: {description: "Enables the checkout", defaultValue: "yes", flagType: string}
+  # Limited by the payment provider
+  maxItems:
+    defaultValue: 0x10
+    flagType: integer
+`,
+			expected: `$schema: https://raw.githubusercontent.com/open-feature/cli/main/schema/v0/flag-manifest.json
+# Flags of the checkout team
+flags:
+  enableCheckout:
+    flagType: string
+    defaultValue: "yes"
+    description: "Enables the checkout"
+  # Limited by the payment provider
+  maxItems:
+    flagType: integer
+    defaultValue: 0x10
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := Format(tt.path, []byte(tt.input), tt.options)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(actual) != tt.expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expected, actual)
+			}
+
+			again, err := Format(tt.path, actual, tt.options)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(again) != string(actual) {
+				t.Errorf("expected formatting to be idempotent, got:\n%s", again)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+)
+
+// File is the content of a manifest file.
+type File struct {
+	Path string
+	Data []byte
+}
+
+// ReadFiles reads the manifest at the given path followed by the manifests it includes,
+// recursively and in a deterministic order. Each file is read only once.
+func ReadFiles(path string) ([]File, error) {
+	var files []File
+	if err := readFiles(filesystem.FileSystem(), filepath.Clean(path), map[string]bool{}, &files); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func readFiles(fs afero.Fs, path string, seen map[string]bool, files *[]File) error {
+	if seen[path] {
+		return nil
+	}
+	seen[path] = true
+
+	data, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return fmt.Errorf("error reading contents from file %q", path)
+	}
+	*files = append(*files, File{Path: path, Data: data})
+
+	var m struct {
+		Includes []string `json:"includes"`
+	}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return fmt.Errorf("error unmarshaling JSON from file %q: %w", path, err)
+	}
+
+	for _, pattern := range m.Includes {
+		matches, err := afero.Glob(fs, filepath.Join(filepath.Dir(path), pattern))
+		if err != nil {
+			return fmt.Errorf("invalid include %q in file %q: %w", pattern, path, err)
+		}
+		if len(matches) == 0 {
+			return fmt.Errorf("include %q in file %q does not match any file", pattern, path)
+		}
+		sort.Strings(matches)
+		for _, match := range matches {
+			if err := readFiles(fs, filepath.Clean(match), seen, files); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DuplicateKeyError is returned when a flag is defined in more than one manifest file.
+type DuplicateKeyError struct {
+	Key    string
+	First  string
+	Second string
+}
+
+func (e *DuplicateKeyError) Error() string {
+	return fmt.Sprintf("flag %q is defined in both %q and %q", e.Key, e.First, e.Second)
+}
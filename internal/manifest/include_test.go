@@ -0,0 +1,87 @@
+package manifest
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+)
+
+func writeFiles(t *testing.T, files map[string]string) {
+	t.Helper()
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	for path, content := range files {
+		if err := afero.WriteFile(fs, path, []byte(content), 0o644); err != nil {
+			t.Fatalf("error writing file %q: %v", path, err)
+		}
+	}
+}
+
+func TestLoadIncludes(t *testing.T) {
+	writeFiles(t, map[string]string{
+		"flags.json":          `{"includes": ["teams/*.json"], "flags": {"root": {"flagType": "boolean", "defaultValue": true}}}`,
+		"teams/checkout.json": `{"flags": {"checkout": {"flagType": "boolean", "defaultValue": false}}}`,
+		"teams/search.json":   `{"includes": ["../flags.json"], "flags": {"search": {"flagType": "string", "defaultValue": "elastic"}}}`,
+	})
+
+	m, err := Load("flags.json", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedSources := map[string]string{
+		"root":     "flags.json",
+		"checkout": "teams/checkout.json",
+		"search":   "teams/search.json",
+	}
+	if !reflect.DeepEqual(m.Sources, expectedSources) {
+		t.Errorf("expected sources %v, got %v", expectedSources, m.Sources)
+	}
+	if len(m.Flags) != 3 {
+		t.Errorf("expected 3 flags, got %d", len(m.Flags))
+	}
+}
+
+func TestLoadIncludesDuplicateKey(t *testing.T) {
+	writeFiles(t, map[string]string{
+		"flags.json":   `{"includes": ["teams/a.json", "teams/b.json"], "flags": {}}`,
+		"teams/a.json": `{"flags": {"shared": {"flagType": "boolean", "defaultValue": false}}}`,
+		"teams/b.json": `{"flags": {"shared": {"flagType": "boolean", "defaultValue": true}}}`,
+	})
+
+	_, err := Load("flags.json", "")
+	var duplicate *DuplicateKeyError
+	if !errors.As(err, &duplicate) {
+		t.Fatalf("expected a DuplicateKeyError, got %v", err)
+	}
+	if duplicate.Key != "shared" || duplicate.First != "teams/a.json" || duplicate.Second != "teams/b.json" {
+		t.Errorf("unexpected duplicate: %+v", duplicate)
+	}
+}
+
+func TestLoadIncludesNoMatch(t *testing.T) {
+	writeFiles(t, map[string]string{
+		"flags.json": `{"includes": ["teams/*.json"], "flags": {}}`,
+	})
+
+	if _, err := Load("flags.json", ""); err == nil {
+		t.Fatal("expected an error for an include without matches")
+	}
+}
+
+func TestLoadWithoutIncludesHasNoSources(t *testing.T) {
+	writeFiles(t, map[string]string{
+		"flags.json": `{"flags": {"root": {"flagType": "boolean", "defaultValue": true}}}`,
+	})
+
+	m, err := Load("flags.json", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.Sources != nil {
+		t.Errorf("expected no sources, got %v", m.Sources)
+	}
+}
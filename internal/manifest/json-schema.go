@@ -52,16 +52,36 @@ type BaseFlag struct {
 	Type string `json:"flagType,omitempty" jsonschema:"required"`
 	// A concise description of this feature flag's purpose.
 	Description string `json:"description,omitempty"`
+	// The group of this feature flag, generated as a nested namespace (e.g., checkout).
+	Group string `json:"group,omitempty" jsonschema:"minLength=1"`
+}
+
+type ContextAttribute struct {
+	// The type of the attribute (e.g., boolean, string, integer, float)
+	Type string `json:"type" jsonschema:"enum=boolean,enum=string,enum=integer,enum=float"`
+	// Whether every evaluation context has to set the attribute
+	Required bool `json:"required,omitempty"`
+	// A concise description of the attribute
+	Description string `json:"description,omitempty"`
 }
 
 // Feature flag manifest for the OpenFeature CLI
 type Manifest struct {
 	// Collection of feature flag definitions
 	Flags map[string]any `json:"flags" jsonschema:"title=Flags,required"`
+	// Glob patterns of manifest files, relative to this manifest, whose flags are merged into it
+	Includes []string `json:"includes,omitempty" jsonschema:"title=Includes"`
+	// Default values of flags overridden per environment, keyed by the name of the environment and the flag key
+	Environments map[string]map[string]EnvironmentFlag `json:"environments,omitempty" jsonschema:"title=Environments"`
+	// Evaluation context attributes the flags depend on, keyed by the name of the attribute
+	Context map[string]ContextAttribute `json:"context,omitempty" jsonschema:"title=Context"`
+	// Sources maps the keys of flags to the manifest file they are defined in.
+	// It is only set for manifests that include other manifests.
+	Sources map[string]string `json:"-"`
 }
 
-// Converts the Manifest struct to a JSON schema.
-func ToJSONSchema() *jsonschema.Schema {
+// Converts the Manifest struct to the JSON schema of the given version.
+func ToJSONSchema(version string) *jsonschema.Schema {
 	reflector := &jsonschema.Reflector{
 		ExpandedStruct:            true,
 		AllowAdditionalProperties: true,
@@ -87,6 +107,26 @@ func ToJSONSchema() *jsonschema.Schema {
 	// We only want flags keys that matches the pattern properties
 	flags.AdditionalProperties = jsonschema.FalseSchema
 
+	environments, ok := schema.Properties.Get("environments")
+	if !ok {
+		log.Fatal("environments not found")
+	}
+	environments.AdditionalProperties.AdditionalProperties = &jsonschema.Schema{
+		Ref: "#/$defs/environmentFlag",
+	}
+
+	context, ok := schema.Properties.Get("context")
+	if !ok {
+		log.Fatal("context not found")
+	}
+	context.AdditionalProperties = &jsonschema.Schema{
+		Ref: "#/$defs/contextAttribute",
+	}
+	// The targeting key is part of every evaluation context
+	context.PropertyNames = &jsonschema.Schema{
+		Not: &jsonschema.Schema{Const: "targetingKey"},
+	}
+
 	schema.Definitions = jsonschema.Definitions{
 		"flag": &jsonschema.Schema{
 			OneOf: []*jsonschema.Schema{
@@ -118,7 +158,48 @@ func ToJSONSchema() *jsonschema.Schema {
 			Type:       "object",
 			Properties: reflector.Reflect(ObjectFlag{}).Properties,
 		},
+		"environmentFlag": &jsonschema.Schema{
+			Type:       "object",
+			Properties: reflector.Reflect(EnvironmentFlag{}).Properties,
+			Required:   []string{"defaultValue"},
+		},
+		"contextAttribute": &jsonschema.Schema{
+			Type:       "object",
+			Properties: reflector.Reflect(ContextAttribute{}).Properties,
+			Required:   []string{"type"},
+		},
+	}
+
+	if version == VersionV1 {
+		strictSchema(schema)
 	}
 
 	return schema
 }
+
+// strictSchema turns the v0 schema into the v1 schema, which requires the version
+// and rejects unknown properties. Custom properties of flags go into their metadata.
+func strictSchema(schema *jsonschema.Schema) {
+	schema.Properties.Set("$schema", &jsonschema.Schema{
+		Type:        "string",
+		Description: "The URL of the JSON schema of the manifest",
+	})
+	schema.Properties.Set("version", &jsonschema.Schema{
+		Type:        "string",
+		Const:       VersionV1,
+		Description: "The version of the manifest schema",
+	})
+	schema.Required = append(schema.Required, "version")
+	schema.AdditionalProperties = jsonschema.FalseSchema
+
+	for _, name := range []string{"booleanFlag", "stringFlag", "integerFlag", "floatFlag", "objectFlag"} {
+		def := schema.Definitions[name]
+		def.Properties.Set("metadata", &jsonschema.Schema{
+			Type:        "object",
+			Description: "Custom properties of the flag, which are not used by the CLI",
+		})
+		def.AdditionalProperties = jsonschema.FalseSchema
+	}
+	schema.Definitions["environmentFlag"].AdditionalProperties = jsonschema.FalseSchema
+	schema.Definitions["contextAttribute"].AdditionalProperties = jsonschema.FalseSchema
+}
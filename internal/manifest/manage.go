@@ -2,9 +2,10 @@ package manifest
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	"github.com/open-feature/cli/internal/filesystem"
-	"github.com/spf13/afero"
 )
 
 type initManifest struct {
@@ -27,18 +28,81 @@ func Create(path string) error {
 	return filesystem.WriteFile(path, formattedInitManifest)
 }
 
+// CreateDraft creates a new manifest file of the latest version at the given path with the given flags,
+// keyed by flag key, in its canonical form. Nothing is written if the manifest isn't valid.
+func CreateDraft(path string, flags map[string]any) error {
+	m := struct {
+		Schema  string         `json:"$schema"`
+		Version string         `json:"version"`
+		Flags   map[string]any `json:"flags"`
+	}{
+		Schema:  SchemaURL(LatestVersion),
+		Version: LatestVersion,
+		Flags:   flags,
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	issues, err := Validate(data)
+	if err != nil {
+		return err
+	}
+	if len(issues) > 0 {
+		messages := make([]string, len(issues))
+		for i, issue := range issues {
+			messages[i] = fmt.Sprintf("%s: %s", issue.Path, issue.Message)
+		}
+		return fmt.Errorf("the draft manifest is invalid: %s", strings.Join(messages, "; "))
+	}
+	formatted, err := Format(path, data, FormatOptions{Indent: 2})
+	if err != nil {
+		return err
+	}
+	return filesystem.WriteFile(path, formatted)
+}
+
 // Load loads a manifest from a JSON file, unmarshals it, and returns a Manifest object.
-func Load(path string) (*Manifest, error) {
-	fs := filesystem.FileSystem()
-	data, err := afero.ReadFile(fs, path)
+// The flags of included manifests are merged into it. If env is not empty,
+// the default values are overridden by the overlay of that environment.
+func Load(path, env string) (*Manifest, error) {
+	files, err := ReadFiles(path)
+	if err != nil {
+		return nil, err
+	}
+	files, err = ApplyEnvironment(files, env)
 	if err != nil {
 		return nil, err
 	}
 
 	var m Manifest
-	if err := json.Unmarshal(data, &m); err != nil {
+	if err := json.Unmarshal(files[0].Data, &m); err != nil {
 		return nil, err
 	}
+	if len(files) == 1 {
+		return &m, nil
+	}
+
+	if m.Flags == nil {
+		m.Flags = map[string]any{}
+	}
+	m.Sources = make(map[string]string, len(m.Flags))
+	for key := range m.Flags {
+		m.Sources[key] = files[0].Path
+	}
+	for _, file := range files[1:] {
+		var included Manifest
+		if err := json.Unmarshal(file.Data, &included); err != nil {
+			return nil, fmt.Errorf("error unmarshaling JSON from file %q: %w", file.Path, err)
+		}
+		for key, flag := range included.Flags {
+			if source, ok := m.Sources[key]; ok {
+				return nil, &DuplicateKeyError{Key: key, First: source, Second: file.Path}
+			}
+			m.Flags[key] = flag
+			m.Sources[key] = file.Path
+		}
+	}
 
 	return &m, nil
 }
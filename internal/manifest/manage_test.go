@@ -0,0 +1,22 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+)
+
+func TestCreateDraftRejectsInvalidManifest(t *testing.T) {
+	writeFiles(t, map[string]string{})
+
+	flags := map[string]any{
+		"": map[string]any{"flagType": "string", "defaultValue": ""},
+	}
+	if err := CreateDraft("flags.json", flags); err == nil {
+		t.Fatal("expected an error for a flag with an empty key")
+	}
+	if exists, _ := afero.Exists(filesystem.FileSystem(), "flags.json"); exists {
+		t.Error("expected the invalid draft not to be written")
+	}
+}
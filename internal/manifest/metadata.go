@@ -0,0 +1,31 @@
+package manifest
+
+// flagProperties are the properties of a flag defined by the v1 schema.
+var flagProperties = map[string]bool{
+	"flagType":     true,
+	"defaultValue": true,
+	"description":  true,
+	"group":        true,
+	"metadata":     true,
+}
+
+// Metadata returns the custom properties of a flag: its metadata object, and for v0 manifests,
+// the properties the schema doesn't define.
+func Metadata(flag any) map[string]any {
+	f, ok := flag.(map[string]any)
+	if !ok {
+		return map[string]any{}
+	}
+	metadata := make(map[string]any)
+	for key, value := range f {
+		if !flagProperties[key] {
+			metadata[key] = value
+		}
+	}
+	if m, ok := f["metadata"].(map[string]any); ok {
+		for key, value := range m {
+			metadata[key] = value
+		}
+	}
+	return metadata
+}
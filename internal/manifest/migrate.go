@@ -0,0 +1,137 @@
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Migrate rewrites the manifest to the given schema version. The order of the properties,
+// the indentation and all values are kept, so that the rewrite is lossless:
+// migrating from v0 to v1 adds the version, points a versioned $schema URL to v1 and moves
+// custom properties of flags into their metadata. Manifests that already have the version are returned unchanged.
+func Migrate(data []byte, to string) ([]byte, error) {
+	if _, ok := schemas[to]; !ok {
+		return nil, fmt.Errorf("unsupported manifest version %q", to)
+	}
+	from, err := Version(data)
+	if err != nil {
+		return nil, err
+	}
+	if from == to {
+		return data, nil
+	}
+	if slices.Index(versions, from) > slices.Index(versions, to) {
+		return nil, fmt.Errorf("cannot migrate manifest from %s to the older version %s", from, to)
+	}
+
+	value, err := decodeOrdered(data)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling JSON: %w", err)
+	}
+	root, ok := value.(*object)
+	if !ok {
+		return nil, errors.New("manifest must be a JSON object")
+	}
+
+	// There is only one migration so far, from v0 to v1.
+	if err := migrateV1(root); err != nil {
+		return nil, err
+	}
+
+	migrated, err := encodeOrdered(root, detectIndent(data))
+	if err != nil {
+		return nil, err
+	}
+	issues, err := Validate(migrated)
+	if err != nil {
+		return nil, err
+	}
+	if len(issues) > 0 {
+		return nil, fmt.Errorf("manifest is not a valid %s manifest after the migration: %s: %s", to, issues[0].Path, issues[0].Message)
+	}
+	return migrated, nil
+}
+
+func migrateV1(root *object) error {
+	index := 0
+	if value, ok := root.get("$schema"); ok {
+		index = 1
+		if url, ok := value.(string); ok {
+			root.set("$schema", schemaVersionPattern.ReplaceAllString(url, "schema/"+VersionV1+"/flag-manifest.json"))
+		}
+	}
+	root.insert(index, "version", VersionV1)
+
+	value, ok := root.get("flags")
+	if !ok {
+		return nil
+	}
+	flags, ok := value.(*object)
+	if !ok {
+		return nil
+	}
+	for _, f := range flags.members {
+		flag, ok := f.value.(*object)
+		if !ok {
+			continue
+		}
+		if err := moveToMetadata(f.key, flag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveToMetadata moves the custom properties of a flag into its metadata,
+// which the v1 schema requires for properties it doesn't define.
+func moveToMetadata(key string, flag *object) error {
+	var custom []member
+	for _, m := range flag.members {
+		if !flagProperties[m.key] {
+			custom = append(custom, m)
+		}
+	}
+	if len(custom) == 0 {
+		return nil
+	}
+
+	metadata := &object{}
+	if value, ok := flag.get("metadata"); ok {
+		existing, ok := value.(*object)
+		if !ok {
+			return fmt.Errorf("metadata of flag %q must be an object", key)
+		}
+		metadata = existing
+	}
+	var conflicts []string
+	for _, m := range custom {
+		if _, ok := metadata.get(m.key); ok {
+			conflicts = append(conflicts, m.key)
+			continue
+		}
+		metadata.set(m.key, m.value)
+		flag.delete(m.key)
+	}
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return fmt.Errorf("cannot move the properties %s of flag %q into its metadata, which already defines them", strings.Join(conflicts, ", "), key)
+	}
+	flag.set("metadata", metadata)
+	return nil
+}
+
+// detectIndent returns the indentation of the first indented line of the JSON document,
+// defaulting to two spaces.
+func detectIndent(data []byte) string {
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		trimmed := bytes.TrimLeft(line, " \t")
+		if len(trimmed) > 0 && len(trimmed) < len(line) {
+			return string(line[:len(line)-len(trimmed)])
+		}
+	}
+	return "  "
+}
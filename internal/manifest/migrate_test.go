@@ -0,0 +1,120 @@
+package manifest
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMigrateV1(t *testing.T) {
+	input := `{
+    "$schema": "https://raw.githubusercontent.com/open-feature/cli/main/schema/v0/flag-manifest.json",
+    "flags": {
+        "enableCheckout": {
+            "flagType": "boolean",
+            "owner": "payments",
+            "defaultValue": false,
+            "description": "Enables the <new> checkout"
+        },
+        "ratio": {"flagType": "float", "defaultValue": 1.50}
+    }
+}`
+	expected := `{
+    "$schema": "https://raw.githubusercontent.com/open-feature/cli/main/schema/v1/flag-manifest.json",
+    "version": "v1",
+    "flags": {
+        "enableCheckout": {
+            "flagType": "boolean",
+            "defaultValue": false,
+            "description": "Enables the <new> checkout",
+            "metadata": {
+                "owner": "payments"
+            }
+        },
+        "ratio": {
+            "flagType": "float",
+            "defaultValue": 1.50
+        }
+    }
+}
+`
+
+	actual, err := Migrate([]byte(input), VersionV1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(actual) != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, actual)
+	}
+
+	again, err := Migrate(actual, VersionV1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(again) != string(actual) {
+		t.Errorf("expected a v1 manifest to be unchanged, got:\n%s", again)
+	}
+}
+
+func TestMigrateErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		to    string
+		err   string
+	}{
+		{
+			name:  "unsupported target",
+			input: `{"flags": {}}`,
+			to:    "v2",
+			err:   `unsupported manifest version "v2"`,
+		},
+		{
+			name:  "downgrade",
+			input: `{"version": "v1", "flags": {}}`,
+			to:    VersionV0,
+			err:   "cannot migrate manifest from v1 to the older version v0",
+		},
+		{
+			name:  "metadata conflict",
+			input: `{"flags": {"a": {"flagType": "boolean", "defaultValue": true, "owner": "x", "metadata": {"owner": "y"}}}}`,
+			to:    VersionV1,
+			err:   `cannot move the properties owner of flag "a" into its metadata, which already defines them`,
+		},
+		{
+			name:  "unknown top-level property",
+			input: `{"owner": "payments", "flags": {}}`,
+			to:    VersionV1,
+			err:   "manifest is not a valid v1 manifest after the migration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Migrate([]byte(tt.input), tt.to)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("expected error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestVersion(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: `{"flags": {}}`, expected: VersionV0},
+		{input: `{"$schema": "https://raw.githubusercontent.com/open-feature/cli/main/schema/v1/flag-manifest.json"}`, expected: VersionV1},
+		{input: `{"$schema": "../schema/v0/flag-manifest.json", "version": "v1"}`, expected: VersionV1},
+	}
+
+	for _, tt := range tests {
+		actual, err := Version([]byte(tt.input))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if actual != tt.expected {
+			t.Errorf("expected version %s of %s, got %s", tt.expected, tt.input, actual)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// object is a JSON object that keeps the order of its members,
+// so that rewriting a manifest doesn't reorder it.
+type object struct {
+	members []member
+}
+
+type member struct {
+	key   string
+	value any
+}
+
+func (o *object) get(key string) (any, bool) {
+	for _, m := range o.members {
+		if m.key == key {
+			return m.value, true
+		}
+	}
+	return nil, false
+}
+
+// set replaces the value of the member with the given key, or appends a new member.
+func (o *object) set(key string, value any) {
+	for i, m := range o.members {
+		if m.key == key {
+			o.members[i].value = value
+			return
+		}
+	}
+	o.members = append(o.members, member{key: key, value: value})
+}
+
+// insert adds a member at the given position, or replaces the value of an existing one in place.
+func (o *object) insert(index int, key string, value any) {
+	if _, ok := o.get(key); ok {
+		o.set(key, value)
+		return
+	}
+	o.members = append(o.members[:index], append([]member{{key: key, value: value}}, o.members[index:]...)...)
+}
+
+func (o *object) delete(key string) {
+	for i, m := range o.members {
+		if m.key == key {
+			o.members = append(o.members[:i], o.members[i+1:]...)
+			return
+		}
+	}
+}
+
+// decodeOrdered decodes JSON into objects, []any, json.Number, string, bool and nil values.
+func decodeOrdered(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	value, err := decodeValue(decoder)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after the top-level value")
+	}
+	return value, nil
+}
+
+func decodeValue(decoder *json.Decoder) (any, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch token {
+	case json.Delim('{'):
+		o := &object{}
+		for decoder.More() {
+			keyToken, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			key, ok := keyToken.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected object key %v", keyToken)
+			}
+			value, err := decodeValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			o.members = append(o.members, member{key: key, value: value})
+		}
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+		return o, nil
+	case json.Delim('['):
+		values := []any{}
+		for decoder.More() {
+			value, err := decodeValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+		return values, nil
+	default:
+		return token, nil
+	}
+}
+
+// encodeOrdered encodes a value decoded by decodeOrdered as JSON indented by the given string.
+func encodeOrdered(value any, indent string) ([]byte, error) {
+	var sb strings.Builder
+	if err := writeValue(&sb, value, indent, ""); err != nil {
+		return nil, err
+	}
+	sb.WriteString("\n")
+	return []byte(sb.String()), nil
+}
+
+func writeValue(sb *strings.Builder, value any, indent, prefix string) error {
+	switch v := value.(type) {
+	case *object:
+		if len(v.members) == 0 {
+			sb.WriteString("{}")
+			return nil
+		}
+		sb.WriteString("{\n")
+		for i, m := range v.members {
+			key, err := marshalScalar(m.key)
+			if err != nil {
+				return err
+			}
+			sb.WriteString(prefix + indent)
+			sb.Write(key)
+			sb.WriteString(": ")
+			if err := writeValue(sb, m.value, indent, prefix+indent); err != nil {
+				return err
+			}
+			if i < len(v.members)-1 {
+				sb.WriteString(",")
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString(prefix + "}")
+	case []any:
+		if len(v) == 0 {
+			sb.WriteString("[]")
+			return nil
+		}
+		sb.WriteString("[\n")
+		for i, item := range v {
+			sb.WriteString(prefix + indent)
+			if err := writeValue(sb, item, indent, prefix+indent); err != nil {
+				return err
+			}
+			if i < len(v)-1 {
+				sb.WriteString(",")
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString(prefix + "]")
+	default:
+		data, err := marshalScalar(v)
+		if err != nil {
+			return err
+		}
+		sb.Write(data)
+	}
+	return nil
+}
+
+// marshalScalar marshals a value without escaping HTML characters, so that strings are kept as written.
+func marshalScalar(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
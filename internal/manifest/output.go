@@ -1,10 +1,10 @@
 package manifest
 
-// OutputFormat represents the available output formats for the compare command
+// OutputFormat represents the available output formats of the commands
 type OutputFormat string
 
 const (
-	// OutputFormatTree represents the tree output format (default)
+	// OutputFormatTree represents the tree output format (default of compare)
 	OutputFormatTree OutputFormat = "tree"
 	// OutputFormatFlat represents the flat output format
 	OutputFormatFlat OutputFormat = "flat"
@@ -12,24 +12,49 @@ const (
 	OutputFormatJSON OutputFormat = "json"
 	// OutputFormatYAML represents the YAML output format
 	OutputFormatYAML OutputFormat = "yaml"
+	// OutputFormatTable represents the table output format
+	OutputFormatTable OutputFormat = "table"
+	// OutputFormatCSV represents the CSV output format
+	OutputFormatCSV OutputFormat = "csv"
 )
 
-// IsValidOutputFormat checks if the given format is a valid output format
-func IsValidOutputFormat(format string) bool {
-	switch OutputFormat(format) {
-	case OutputFormatTree, OutputFormatFlat, OutputFormatJSON, OutputFormatYAML:
-		return true
-	default:
-		return false
+// OutputFormats are the output formats a command supports
+type OutputFormats []OutputFormat
+
+// The output formats of the commands
+var (
+	CompareOutputFormats = OutputFormats{OutputFormatTree, OutputFormatFlat, OutputFormatJSON, OutputFormatYAML}
+	LintOutputFormats    = OutputFormats{OutputFormatTable, OutputFormatJSON, OutputFormatYAML}
+	ListOutputFormats    = OutputFormats{OutputFormatTable, OutputFormatJSON, OutputFormatYAML, OutputFormatCSV}
+	ShowOutputFormats    = OutputFormats{OutputFormatTable, OutputFormatJSON, OutputFormatYAML}
+	UsageOutputFormats   = OutputFormats{OutputFormatTable, OutputFormatJSON}
+)
+
+// IsValid checks if the given format is one of the output formats
+func (f OutputFormats) IsValid(format string) bool {
+	for _, outputFormat := range f {
+		if string(outputFormat) == format {
+			return true
+		}
 	}
+	return false
 }
 
-// GetValidOutputFormats returns a list of all valid output formats
-func GetValidOutputFormats() []string {
-	return []string{
-		string(OutputFormatTree),
-		string(OutputFormatFlat),
-		string(OutputFormatJSON),
-		string(OutputFormatYAML),
+// Strings returns the output formats as strings
+func (f OutputFormats) Strings() []string {
+	formats := make([]string, 0, len(f))
+	for _, outputFormat := range f {
+		formats = append(formats, string(outputFormat))
 	}
+	return formats
+}
+
+// IsValidOutputFormat checks if the given format is a valid output format of the compare command
+func IsValidOutputFormat(format string) bool {
+	return CompareOutputFormats.IsValid(format)
+}
+
+// GetValidOutputFormats returns a list of all valid output formats of the compare command
+func GetValidOutputFormats() []string {
+	return CompareOutputFormats.Strings()
 }
@@ -0,0 +1,296 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+)
+
+// EnvironmentFlag overrides the properties of a flag for an environment.
+type EnvironmentFlag struct {
+	// The value returned from an unsuccessful flag evaluation in this environment
+	DefaultValue any `json:"defaultValue" jsonschema:"required"`
+}
+
+type override struct {
+	value  any
+	source string
+}
+
+// OverlayPath returns the path of the sidecar file holding the overlay of the given environment,
+// e.g. flags.production.json for the manifest flags.json.
+func OverlayPath(manifestPath, env string) string {
+	ext := filepath.Ext(manifestPath)
+	return strings.TrimSuffix(manifestPath, ext) + "." + env + ext
+}
+
+// ApplyEnvironment overrides the default values of the flags in the manifest files
+// by the overlay of the given environment. The overlays are read from the environments
+// of the manifest files and from the sidecar files of the first one, see OverlayPath.
+// The overlays of all environments are validated, so that a broken overlay is reported
+// before the environment is deployed. An empty environment returns the files unchanged.
+func ApplyEnvironment(files []File, env string) ([]File, error) {
+	if len(files) == 0 {
+		return files, nil
+	}
+
+	manifests := make([]map[string]any, len(files))
+	for i, file := range files {
+		if err := decode(file.Data, &manifests[i]); err != nil {
+			return nil, fmt.Errorf("error unmarshaling JSON from file %q: %w", file.Path, err)
+		}
+	}
+
+	overlays, err := readOverlays(files, manifests)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range sortedKeys(overlays) {
+		overrides := overlays[name]
+		for _, key := range sortedKeys(overrides) {
+			o := overrides[key]
+			_, flag := findFlag(manifests, key)
+			if flag == nil {
+				return nil, fmt.Errorf("environment %q in %q overrides the unknown flag %q", name, o.source, key)
+			}
+			flagType, _ := flag["flagType"].(string)
+			if !matchesType(flagType, o.value) {
+				return nil, fmt.Errorf("environment %q in %q sets the default value of flag %q to %v, which is not of type %s", name, o.source, key, o.value, flagType)
+			}
+		}
+	}
+
+	if env == "" {
+		return files, nil
+	}
+	overrides, ok := overlays[env]
+	if !ok {
+		return nil, fmt.Errorf("no overlay found for environment %q, expected %q or an entry in the environments of the manifest", env, OverlayPath(files[0].Path, env))
+	}
+
+	changed := make(map[int]bool)
+	for key, o := range overrides {
+		i, flag := findFlag(manifests, key)
+		flag["defaultValue"] = o.value
+		changed[i] = true
+	}
+
+	result := make([]File, len(files))
+	copy(result, files)
+	for i := range changed {
+		data, err := json.Marshal(manifests[i])
+		if err != nil {
+			return nil, err
+		}
+		result[i].Data = data
+	}
+	return result, nil
+}
+
+// EnvironmentDefaults returns the default values of a flag overridden by the environments,
+// keyed by environment. The overlays are resolved like in ApplyEnvironment, from the environments
+// of the manifest files and from the sidecar files of the first one.
+func EnvironmentDefaults(files []File, key string) (map[string]any, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	manifests := make([]map[string]any, len(files))
+	for i, file := range files {
+		if err := decode(file.Data, &manifests[i]); err != nil {
+			return nil, fmt.Errorf("error unmarshaling JSON from file %q: %w", file.Path, err)
+		}
+	}
+
+	overlays, err := readOverlays(files, manifests)
+	if err != nil {
+		return nil, err
+	}
+	var defaults map[string]any
+	for env, overrides := range overlays {
+		o, ok := overrides[key]
+		if !ok {
+			continue
+		}
+		// Re-decode without json.Number, so that numbers are encoded as numbers by every output format
+		data, err := json.Marshal(o.value)
+		if err != nil {
+			return nil, err
+		}
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			return nil, err
+		}
+		if defaults == nil {
+			defaults = map[string]any{}
+		}
+		defaults[env] = value
+	}
+	return defaults, nil
+}
+
+// readOverlays collects the default values of all environments, keyed by environment and flag key.
+func readOverlays(files []File, manifests []map[string]any) (map[string]map[string]override, error) {
+	overlays := make(map[string]map[string]override)
+	add := func(env string, flags map[string]any, source string) error {
+		overrides, ok := overlays[env]
+		if !ok {
+			overrides = make(map[string]override)
+			overlays[env] = overrides
+		}
+		for key, value := range flags {
+			flag, ok := value.(map[string]any)
+			if !ok {
+				return fmt.Errorf("environment %q in %q has an invalid override for flag %q", env, source, key)
+			}
+			defaultValue, ok := flag["defaultValue"]
+			if !ok {
+				return fmt.Errorf("environment %q in %q doesn't set the default value of flag %q", env, source, key)
+			}
+			if other, ok := overrides[key]; ok {
+				return fmt.Errorf("environment %q overrides flag %q in both %q and %q", env, key, other.source, source)
+			}
+			overrides[key] = override{value: defaultValue, source: source}
+		}
+		return nil
+	}
+
+	seen := make(map[string]bool, len(files))
+	for i, m := range manifests {
+		seen[files[i].Path] = true
+		environments, ok := m["environments"]
+		if !ok {
+			continue
+		}
+		envs, ok := environments.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("the environments of %q must be an object", files[i].Path)
+		}
+		for _, env := range sortedKeys(envs) {
+			flags, ok := envs[env].(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("environment %q in %q must be an object", env, files[i].Path)
+			}
+			if err := add(env, flags, files[i].Path); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The sidecar files of all environments, e.g. flags.production.json. Siblings that aren't overlays are skipped.
+	fs := filesystem.FileSystem()
+	pattern := OverlayPath(files[0].Path, "*")
+	sidecars, err := afero.Glob(fs, pattern)
+	if err != nil {
+		return nil, err
+	}
+	prefix, suffix, _ := strings.Cut(pattern, "*")
+	for _, sidecarPath := range sidecars {
+		if seen[sidecarPath] {
+			continue
+		}
+		env := strings.TrimSuffix(strings.TrimPrefix(sidecarPath, prefix), suffix)
+		data, err := afero.ReadFile(fs, sidecarPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading contents from file %q", sidecarPath)
+		}
+		var sidecar any
+		if err := decode(data, &sidecar); err != nil {
+			return nil, fmt.Errorf("error unmarshaling JSON from file %q: %w", sidecarPath, err)
+		}
+		flags, ok := overlayFlags(sidecar)
+		if !ok {
+			continue
+		}
+		if err := add(env, flags, sidecarPath); err != nil {
+			return nil, err
+		}
+	}
+	return overlays, nil
+}
+
+// overlayFlags returns the flags of a sidecar file if it is an overlay, i.e. an object that only has
+// flags and optionally a $schema. Other files next to the manifest, such as copies of the manifest
+// whose flags declare a flagType or JSON schemas, are not overlays.
+func overlayFlags(sidecar any) (map[string]any, bool) {
+	m, ok := sidecar.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	for key := range m {
+		if key != "flags" && key != "$schema" {
+			return nil, false
+		}
+	}
+	flags, ok := m["flags"].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	for _, value := range flags {
+		if flag, ok := value.(map[string]any); ok {
+			if _, ok := flag["flagType"]; ok {
+				return nil, false
+			}
+		}
+	}
+	return flags, true
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func findFlag(manifests []map[string]any, key string) (int, map[string]any) {
+	for i, m := range manifests {
+		flags, _ := m["flags"].(map[string]any)
+		if flag, ok := flags[key].(map[string]any); ok {
+			return i, flag
+		}
+	}
+	return -1, nil
+}
+
+// matchesType reports whether the value can be the default value of a flag of the given type.
+func matchesType(flagType string, value any) bool {
+	switch flagType {
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "integer":
+		n, ok := value.(json.Number)
+		if !ok {
+			return false
+		}
+		_, err := n.Int64()
+		return err == nil
+	case "float":
+		_, ok := value.(json.Number)
+		return ok
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	default:
+		return false
+	}
+}
+
+// decode unmarshals JSON keeping numbers as json.Number, so that integers and floats can be told apart.
+func decode(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
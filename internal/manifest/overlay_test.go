@@ -0,0 +1,164 @@
+package manifest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const overlayManifest = `{
+  "flags": {
+    "enableCheckout": {"flagType": "boolean", "defaultValue": false},
+    "maxItems": {"flagType": "integer", "defaultValue": 10},
+    "ratio": {"flagType": "float", "defaultValue": 0.5}
+  },
+  "environments": {
+    "staging": {
+      "enableCheckout": {"defaultValue": true}
+    }
+  }
+}`
+
+func TestLoadEnvironment(t *testing.T) {
+	writeFiles(t, map[string]string{
+		"flags.json":            overlayManifest,
+		"flags.production.json": `{"flags": {"maxItems": {"defaultValue": 100}, "ratio": {"defaultValue": 1}}}`,
+	})
+
+	tests := []struct {
+		env      string
+		expected map[string]string
+	}{
+		{env: "", expected: map[string]string{"enableCheckout": "false", "maxItems": "10", "ratio": "0.5"}},
+		{env: "staging", expected: map[string]string{"enableCheckout": "true", "maxItems": "10", "ratio": "0.5"}},
+		{env: "production", expected: map[string]string{"enableCheckout": "false", "maxItems": "100", "ratio": "1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.env, func(t *testing.T) {
+			m, err := Load("flags.json", tt.env)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for key, expected := range tt.expected {
+				flag := m.Flags[key].(map[string]any)
+				if actual := fmt.Sprint(flag["defaultValue"]); actual != expected {
+					t.Errorf("expected default value of %q to be %s, got %s", key, expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadSkipsSiblingsThatAreNotOverlays(t *testing.T) {
+	writeFiles(t, map[string]string{
+		"flags.json":            overlayManifest,
+		"flags.backup.json":     `{"flags": {"maxItems": {"flagType": "integer", "defaultValue": "many"}}}`,
+		"flags.schema.json":     `{"$schema": "https://json-schema.org/draft/2020-12/schema", "type": "object"}`,
+		"flags.production.json": `{"flags": {"maxItems": {"defaultValue": 100}}}`,
+	})
+
+	m, err := Load("flags.json", "production")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actual := fmt.Sprint(m.Flags["maxItems"].(map[string]any)["defaultValue"]); actual != "100" {
+		t.Errorf("expected default value of %q to be 100, got %s", "maxItems", actual)
+	}
+
+	for _, env := range []string{"backup", "schema"} {
+		if _, err := Load("flags.json", env); err == nil || !strings.Contains(err.Error(), "no overlay found") {
+			t.Errorf("expected no overlay for environment %q, got %v", env, err)
+		}
+	}
+}
+
+func TestLoadEnvironmentValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		sidecar string
+		env     string
+		err     string
+	}{
+		{
+			name:    "unknown flag",
+			sidecar: `{"flags": {"unknown": {"defaultValue": true}}}`,
+			env:     "production",
+			err:     `environment "production" in "flags.production.json" overrides the unknown flag "unknown"`,
+		},
+		{
+			name:    "inconsistent type",
+			sidecar: `{"flags": {"maxItems": {"defaultValue": 1.5}}}`,
+			env:     "production",
+			err:     `sets the default value of flag "maxItems" to 1.5, which is not of type integer`,
+		},
+		{
+			name:    "overridden twice",
+			sidecar: `{"flags": {"enableCheckout": {"defaultValue": false}}}`,
+			env:     "staging",
+			err:     `environment "staging" overrides flag "enableCheckout" in both "flags.json" and "flags.staging.json"`,
+		},
+		{
+			name: "missing overlay",
+			env:  "qa",
+			err:  `no overlay found for environment "qa"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := map[string]string{"flags.json": overlayManifest}
+			if tt.sidecar != "" {
+				files[OverlayPath("flags.json", tt.env)] = tt.sidecar
+			}
+			writeFiles(t, files)
+
+			_, err := Load("flags.json", tt.env)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("expected error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestLoadValidatesAllEnvironments(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		err   string
+	}{
+		{
+			name: "sidecar of another environment",
+			files: map[string]string{
+				"flags.json":            overlayManifest,
+				"flags.production.json": `{"flags": {"maxItems": {"defaultValue": "many"}}}`,
+			},
+			err: `environment "production" in "flags.production.json" sets the default value of flag "maxItems" to many, which is not of type integer`,
+		},
+		{
+			name: "environment of the manifest",
+			files: map[string]string{
+				"flags.json": `{"flags": {"maxItems": {"flagType": "integer", "defaultValue": 10}}, "environments": {"qa": {"minItems": {"defaultValue": 1}}}}`,
+			},
+			err: `environment "qa" in "flags.json" overrides the unknown flag "minItems"`,
+		},
+		{
+			name: "malformed environment",
+			files: map[string]string{
+				"flags.json": `{"flags": {"maxItems": {"flagType": "integer", "defaultValue": 10}}, "environments": {"qa": true}}`,
+			},
+			err: `environment "qa" in "flags.json" must be an object`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeFiles(t, tt.files)
+
+			_, err := Load("flags.json", "")
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("expected error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package manifest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+)
+
+// RemoveFlag removes the flag with the given key from the manifest at the given path and the manifests it includes,
+// along with its overrides in the environments of the manifests and in the sidecar overlays of the manifest.
+// The order of the properties and the indentation of the files are kept. It returns the changed files
+// without writing them.
+func RemoveFlag(path, key string) ([]File, error) {
+	files, err := ReadFiles(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []File
+	defined := false
+	for _, file := range files {
+		data, found, removed, err := removeFlag(file.Data, key)
+		if err != nil {
+			return nil, fmt.Errorf("error removing flag %q from %q: %w", key, file.Path, err)
+		}
+		defined = defined || found
+		if removed {
+			changed = append(changed, File{Path: file.Path, Data: data})
+		}
+	}
+	if !defined {
+		return nil, fmt.Errorf("flag %q is not defined in the manifest", key)
+	}
+
+	// The sidecar overlays of all environments, e.g. flags.production.json
+	fs := filesystem.FileSystem()
+	sidecars, err := afero.Glob(fs, OverlayPath(files[0].Path, "*"))
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(files))
+	for _, file := range files {
+		seen[file.Path] = true
+	}
+	for _, sidecar := range sidecars {
+		if seen[sidecar] {
+			continue
+		}
+		data, err := afero.ReadFile(fs, sidecar)
+		if err != nil {
+			return nil, fmt.Errorf("error reading contents from file %q", sidecar)
+		}
+		data, _, removed, err := removeFlag(data, key)
+		if err != nil {
+			return nil, fmt.Errorf("error removing flag %q from %q: %w", key, sidecar, err)
+		}
+		if removed {
+			changed = append(changed, File{Path: sidecar, Data: data})
+		}
+	}
+	return changed, nil
+}
+
+// removeFlag removes the flag from the flags and the environments of the JSON manifest data,
+// reporting whether the flag was defined in the flags and whether anything was removed
+func removeFlag(data []byte, key string) (result []byte, defined, removed bool, err error) {
+	value, err := decodeOrdered(data)
+	if err != nil {
+		return nil, false, false, fmt.Errorf("error unmarshaling JSON: %w", err)
+	}
+	root, ok := value.(*object)
+	if !ok {
+		return nil, false, false, errors.New("manifest must be a JSON object")
+	}
+
+	remove := func(o any) bool {
+		if o, ok := o.(*object); ok {
+			if _, ok := o.get(key); ok {
+				o.delete(key)
+				return true
+			}
+		}
+		return false
+	}
+	flags, _ := root.get("flags")
+	defined = remove(flags)
+	removed = defined
+	if environments, ok := root.get("environments"); ok {
+		if environments, ok := environments.(*object); ok {
+			for _, env := range environments.members {
+				removed = remove(env.value) || removed
+			}
+		}
+	}
+	if !removed {
+		return data, defined, false, nil
+	}
+
+	result, err = encodeOrdered(root, detectIndent(data))
+	if err != nil {
+		return nil, false, false, err
+	}
+	return result, defined, true, nil
+}
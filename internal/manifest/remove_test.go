@@ -0,0 +1,61 @@
+package manifest
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRemoveFlag(t *testing.T) {
+	writeFiles(t, map[string]string{
+		"flags.json":            overlayManifest,
+		"flags.production.json": `{"flags": {"maxItems": {"defaultValue": 100}}}`,
+		"flags.staging.json":    `{"flags": {"enableCheckout": {"defaultValue": true}}}`,
+	})
+
+	files, err := RemoveFlag("flags.json", "enableCheckout")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"flags.json": `{
+  "flags": {
+    "maxItems": {
+      "flagType": "integer",
+      "defaultValue": 10
+    },
+    "ratio": {
+      "flagType": "float",
+      "defaultValue": 0.5
+    }
+  },
+  "environments": {
+    "staging": {}
+  }
+}
+`,
+		"flags.staging.json": `{
+  "flags": {}
+}
+`,
+	}
+	if len(files) != len(expected) {
+		t.Fatalf("expected %d changed files, got %d", len(expected), len(files))
+	}
+	for _, file := range files {
+		if actual := string(file.Data); actual != expected[file.Path] {
+			t.Errorf("unexpected content of %q:\n%s", file.Path, actual)
+		}
+	}
+}
+
+func TestRemoveFlagNotDefined(t *testing.T) {
+	writeFiles(t, map[string]string{
+		"flags.json": overlayManifest,
+	})
+
+	_, err := RemoveFlag("flags.json", "missing")
+	if err == nil || !strings.Contains(err.Error(), `flag "missing" is not defined`) {
+		t.Errorf("expected an undefined flag error, got %v", err)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/open-feature/cli/schema/v0"
 	"github.com/xeipuuv/gojsonschema"
 )
 
@@ -12,10 +11,17 @@ type ValidationError struct {
 	Type    string `json:"type"`
 	Path    string `json:"path"`
 	Message string `json:"message"`
+	// File is the manifest file the error occurred in, set for manifests that include other manifests
+	File string `json:"file,omitempty"`
 }
 
+// Validate validates the manifest against the JSON schema of its version.
 func Validate(data []byte) ([]ValidationError, error) {
-	schemaLoader := gojsonschema.NewStringLoader(schema.SchemaFile)
+	version, err := Version(data)
+	if err != nil {
+		return nil, err
+	}
+	schemaLoader := gojsonschema.NewStringLoader(schemas[version])
 	manifestLoader := gojsonschema.NewBytesLoader(data)
 
 	result, err := gojsonschema.Validate(schemaLoader, manifestLoader)
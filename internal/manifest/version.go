@@ -0,0 +1,59 @@
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	schemav0 "github.com/open-feature/cli/schema/v0"
+	schemav1 "github.com/open-feature/cli/schema/v1"
+)
+
+// Versions of the manifest schema.
+const (
+	VersionV0 = "v0"
+	VersionV1 = "v1"
+	// LatestVersion is the version manifests are migrated to by default.
+	LatestVersion = VersionV1
+)
+
+// versions are the supported versions, from the oldest to the latest.
+var versions = []string{VersionV0, VersionV1}
+
+// schemas are the JSON schemas of the supported versions.
+var schemas = map[string]string{
+	VersionV0: schemav0.SchemaFile,
+	VersionV1: schemav1.SchemaFile,
+}
+
+var schemaVersionPattern = regexp.MustCompile(`schema/(v\d+)/flag-manifest\.json$`)
+
+// SchemaURL returns the URL of the JSON schema of the given version.
+func SchemaURL(version string) string {
+	return "https://raw.githubusercontent.com/open-feature/cli/main/schema/" + version + "/flag-manifest.json"
+}
+
+// Version returns the schema version of the manifest. It is selected by the version field,
+// or else by the $schema URL. Manifests without either are v0 manifests.
+func Version(data []byte) (string, error) {
+	var m struct {
+		Schema  string `json:"$schema"`
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return "", fmt.Errorf("error unmarshaling JSON: %w", err)
+	}
+
+	version := m.Version
+	if version == "" {
+		if match := schemaVersionPattern.FindStringSubmatch(m.Schema); match != nil {
+			version = match[1]
+		} else {
+			version = VersionV0
+		}
+	}
+	if _, ok := schemas[version]; !ok {
+		return "", fmt.Errorf("unsupported manifest version %q", version)
+	}
+	return version, nil
+}
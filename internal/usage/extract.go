@@ -0,0 +1,386 @@
+package usage
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/logger"
+)
+
+// Evaluation is an evaluation of the OpenFeature SDK with a string literal flag key found in source code.
+type Evaluation struct {
+	Key  string
+	Type flagset.FlagType
+	// DefaultValue is the default value of the evaluation, or nil if it isn't a literal
+	DefaultValue any
+	Location     Location
+}
+
+// EvaluationExtensions are the extensions of the files whose evaluations are found.
+// Go files are parsed, the others are matched against evaluationPattern.
+var EvaluationExtensions = []string{".go", ".js", ".jsx", ".mjs", ".cjs", ".ts", ".tsx", ".java", ".py", ".cs"}
+
+// goEvaluationMethods are the flag types of the evaluation methods of the Go SDK client
+var goEvaluationMethods = map[string]flagset.FlagType{
+	"Boolean":             flagset.BoolType,
+	"BooleanValue":        flagset.BoolType,
+	"BooleanValueDetails": flagset.BoolType,
+	"String":              flagset.StringType,
+	"StringValue":         flagset.StringType,
+	"StringValueDetails":  flagset.StringType,
+	"Int":                 flagset.IntType,
+	"IntValue":            flagset.IntType,
+	"IntValueDetails":     flagset.IntType,
+	"Float":               flagset.FloatType,
+	"FloatValue":          flagset.FloatType,
+	"FloatValueDetails":   flagset.FloatType,
+	"Object":              flagset.ObjectType,
+	"ObjectValue":         flagset.ObjectType,
+	"ObjectValueDetails":  flagset.ObjectType,
+}
+
+// evaluationPattern matches evaluations whose flag key is the first argument, e.g.
+// client.getStringValue('key', 'x'), client.get_boolean_value("key", False) or
+// client.GetIntegerValueAsync("key", 1). The groups are the type, the flag key and the default value.
+var evaluationPattern = regexp.MustCompile(
+	`(?i)\b(?:get_?|use)?(boolean|bool|string|number|integer|int|float|double|object|structure)_?(?:flag_?)?(?:value|details)(?:_?details)?(?:_?async)?\s*\(\s*` +
+		"(\"(?:[^\"\\\\]|\\\\.)*\"|'(?:[^'\\\\]|\\\\.)*'|`[^`]*`)" +
+		`\s*,\s*([^,()]*)`,
+)
+
+// flagKeyPattern matches the flag keys of evaluations: a letter or digit followed by letters, digits, '.', '_', '-', ':' or '/'.
+// Other string literals, e.g. empty strings or paths like ".", are rarely flag keys.
+var flagKeyPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._:/-]*$`)
+
+var (
+	integerLiteral = regexp.MustCompile(`^-?\d+[lL]?$`)
+	floatLiteral   = regexp.MustCompile(`^-?(\d+\.\d*|\.\d+|\d+(\.\d*)?[eE][-+]?\d+|\d+(\.\d*)?[fFdDmM])$`)
+	objectLiteral  = regexp.MustCompile(`^\{\s*\}$`)
+)
+
+// FindEvaluations walks the directory and returns the evaluations in its source files,
+// in the order of the files and lines. Directories and files are skipped as by Scanner.Scan,
+// and Go files that can't be parsed are skipped with a warning.
+func FindEvaluations(dir string, exclude []string) ([]Evaluation, error) {
+	var evaluations []Evaluation
+	err := Walk(dir, exclude, EvaluationExtensions, func(file string, data []byte) error {
+		if path.Ext(file) == ".go" {
+			found, err := goEvaluations(file, data)
+			if err != nil {
+				// A file that doesn't compile, e.g. test data, shouldn't stop the search
+				logger.Default.Warning(err.Error())
+				return nil
+			}
+			evaluations = append(evaluations, found...)
+			return nil
+		}
+		evaluations = append(evaluations, patternEvaluations(file, string(data))...)
+		return nil
+	})
+	return evaluations, err
+}
+
+// goEvaluations returns the evaluations of the Go SDK in the file, e.g. client.BooleanValue(ctx, "key", false, evalCtx).
+// Calls of methods with the same names, e.g. cmd.Flags().String("name", "", "usage"), are told apart by the signature
+// of the SDK: a context, the flag key, the default value and the evaluation context.
+func goEvaluations(file string, data []byte) ([]Evaluation, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, data, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %q: %w", file, err)
+	}
+
+	var evaluations []Evaluation
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) < 4 {
+			return true
+		}
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		flagType, ok := goEvaluationMethods[selector.Sel.Name]
+		if !ok || !isGoContext(call.Args[0]) && !isGoClient(selector.X) {
+			return true
+		}
+		keyLit, ok := call.Args[1].(*ast.BasicLit)
+		if !ok || keyLit.Kind != token.STRING {
+			return true
+		}
+		key, err := strconv.Unquote(keyLit.Value)
+		if err != nil || !flagKeyPattern.MatchString(key) {
+			return true
+		}
+
+		evaluations = append(evaluations, Evaluation{
+			Key:          key,
+			Type:         flagType,
+			DefaultValue: goLiteral(call.Args[2], flagType),
+			Location:     Location{File: file, Line: fset.Position(call.Pos()).Line, Kind: KindSDK},
+		})
+		return true
+	})
+	return evaluations, nil
+}
+
+// isGoContext reports whether the expression looks like a context.Context, e.g. ctx, r.Context() or context.Background()
+func isGoContext(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return isContextName(e.Name)
+	case *ast.SelectorExpr:
+		return isContextName(e.Sel.Name)
+	case *ast.CallExpr:
+		selector, ok := e.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return false
+		}
+		if pkg, ok := selector.X.(*ast.Ident); ok && pkg.Name == "context" {
+			return true
+		}
+		return selector.Sel.Name == "Context"
+	}
+	return false
+}
+
+func isContextName(name string) bool {
+	name = strings.ToLower(name)
+	return strings.HasSuffix(name, "ctx") || strings.HasSuffix(name, "context")
+}
+
+// isGoClient reports whether the receiver looks like an OpenFeature client, e.g. client, s.flagClient or
+// openfeature.NewDefaultClient()
+func isGoClient(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return strings.Contains(strings.ToLower(e.Name), "client")
+	case *ast.SelectorExpr:
+		return strings.Contains(strings.ToLower(e.Sel.Name), "client")
+	case *ast.CallExpr:
+		return isGoClient(e.Fun)
+	}
+	return false
+}
+
+// goLiteral returns the value of a literal expression of the flag type, or nil if it isn't one
+func goLiteral(expr ast.Expr, flagType flagset.FlagType) any {
+	negative := false
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.SUB {
+		negative = true
+		expr = unary.X
+	}
+
+	switch lit := expr.(type) {
+	case *ast.Ident:
+		if flagType == flagset.BoolType && !negative && (lit.Name == "true" || lit.Name == "false") {
+			return lit.Name == "true"
+		}
+	case *ast.BasicLit:
+		switch {
+		case lit.Kind == token.STRING && flagType == flagset.StringType && !negative:
+			value, err := strconv.Unquote(lit.Value)
+			if err == nil {
+				return value
+			}
+		case lit.Kind == token.INT && flagType == flagset.IntType:
+			value, err := strconv.ParseInt(lit.Value, 0, 64)
+			if err == nil {
+				if negative {
+					value = -value
+				}
+				return value
+			}
+		case (lit.Kind == token.INT || lit.Kind == token.FLOAT) && flagType == flagset.FloatType:
+			value, err := strconv.ParseFloat(lit.Value, 64)
+			if err == nil {
+				if negative {
+					value = -value
+				}
+				return value
+			}
+		}
+	}
+	return nil
+}
+
+// patternEvaluations returns the evaluations matching evaluationPattern in the file
+func patternEvaluations(file, data string) []Evaluation {
+	var evaluations []Evaluation
+	for _, match := range evaluationPattern.FindAllStringSubmatchIndex(data, -1) {
+		typeName := strings.ToLower(data[match[2]:match[3]])
+		key := unquote(data[match[4]:match[5]])
+		if !flagKeyPattern.MatchString(key) {
+			continue
+		}
+		defaultValue := parseLiteral(strings.TrimSpace(data[match[6]:match[7]]))
+
+		var flagType flagset.FlagType
+		switch typeName {
+		case "boolean", "bool":
+			flagType = flagset.BoolType
+		case "string":
+			flagType = flagset.StringType
+		case "integer", "int":
+			flagType = flagset.IntType
+		case "float", "double":
+			flagType = flagset.FloatType
+		case "object", "structure":
+			flagType = flagset.ObjectType
+		case "number":
+			// JavaScript has a single number type, the default value tells integers from floats
+			flagType = flagset.FloatType
+			if _, ok := defaultValue.(int64); ok {
+				flagType = flagset.IntType
+			}
+		}
+
+		evaluations = append(evaluations, Evaluation{
+			Key:          key,
+			Type:         flagType,
+			DefaultValue: convertLiteral(defaultValue, flagType),
+			Location:     Location{File: file, Line: strings.Count(data[:match[0]], "\n") + 1, Kind: KindSDK},
+		})
+	}
+	return evaluations
+}
+
+// parseLiteral returns the value of a boolean, number, string or empty object literal, or nil
+func parseLiteral(text string) any {
+	switch {
+	case strings.EqualFold(text, "true"):
+		return true
+	case strings.EqualFold(text, "false"):
+		return false
+	case integerLiteral.MatchString(text):
+		value, err := strconv.ParseInt(strings.TrimRight(text, "lL"), 10, 64)
+		if err == nil {
+			return value
+		}
+	case floatLiteral.MatchString(text):
+		value, err := strconv.ParseFloat(strings.TrimRight(text, "fFdDmM"), 64)
+		if err == nil {
+			return value
+		}
+	case objectLiteral.MatchString(text):
+		return map[string]any{}
+	case len(text) >= 2 && strings.ContainsAny(text[:1], "\"'`") && text[len(text)-1] == text[0]:
+		return unquote(text)
+	}
+	return nil
+}
+
+// convertLiteral returns the literal as a value of the flag type, or nil if it isn't one
+func convertLiteral(value any, flagType flagset.FlagType) any {
+	switch v := value.(type) {
+	case bool:
+		if flagType == flagset.BoolType {
+			return v
+		}
+	case string:
+		if flagType == flagset.StringType {
+			return v
+		}
+	case int64:
+		switch flagType {
+		case flagset.IntType:
+			return v
+		case flagset.FloatType:
+			return float64(v)
+		}
+	case float64:
+		if flagType == flagset.FloatType {
+			return v
+		}
+	case map[string]any:
+		if flagType == flagset.ObjectType {
+			return v
+		}
+	}
+	return nil
+}
+
+// unquote removes the quotes of a string literal, unescaping it if it is a valid Go string literal
+func unquote(text string) string {
+	if value, err := strconv.Unquote(text); err == nil {
+		return value
+	}
+	return text[1 : len(text)-1]
+}
+
+// DraftFlag is a flag inferred from the evaluations of its key.
+type DraftFlag struct {
+	Key          string
+	Type         flagset.FlagType
+	DefaultValue any
+	// Conflicts describe evaluations that disagree on the type or the default value of the flag,
+	// and defaults that couldn't be inferred, for review
+	Conflicts []string
+}
+
+// Draft infers the flags of the evaluations, in the order of their first evaluation.
+// The type and the default value of a flag are taken from its first evaluation with a literal default value,
+// the evaluations that disagree with them are reported as conflicts.
+func Draft(evaluations []Evaluation) []DraftFlag {
+	var keys []string
+	byKey := map[string][]Evaluation{}
+	for _, evaluation := range evaluations {
+		if _, ok := byKey[evaluation.Key]; !ok {
+			keys = append(keys, evaluation.Key)
+		}
+		byKey[evaluation.Key] = append(byKey[evaluation.Key], evaluation)
+	}
+
+	flags := make([]DraftFlag, 0, len(keys))
+	for _, key := range keys {
+		evaluations := byKey[key]
+		first := evaluations[0]
+		for _, evaluation := range evaluations {
+			if evaluation.DefaultValue != nil {
+				first = evaluation
+				break
+			}
+		}
+
+		flag := DraftFlag{Key: key, Type: first.Type, DefaultValue: first.DefaultValue}
+		for _, evaluation := range evaluations {
+			switch {
+			case evaluation.Type != first.Type:
+				flag.Conflicts = append(flag.Conflicts, fmt.Sprintf("evaluated as %s at %s, but as %s at %s",
+					first.Type.ManifestType(), first.Location, evaluation.Type.ManifestType(), evaluation.Location))
+			case evaluation.DefaultValue != nil && !reflect.DeepEqual(evaluation.DefaultValue, first.DefaultValue):
+				flag.Conflicts = append(flag.Conflicts, fmt.Sprintf("default value %v at %s, but %v at %s",
+					first.DefaultValue, first.Location, evaluation.DefaultValue, evaluation.Location))
+			}
+		}
+		if flag.DefaultValue == nil {
+			flag.DefaultValue = zeroValue(flag.Type)
+			flag.Conflicts = append(flag.Conflicts, fmt.Sprintf("no literal default value found, using %v", flag.DefaultValue))
+		}
+		flags = append(flags, flag)
+	}
+	return flags
+}
+
+func zeroValue(flagType flagset.FlagType) any {
+	switch flagType {
+	case flagset.BoolType:
+		return false
+	case flagset.StringType:
+		return ""
+	case flagset.IntType:
+		return int64(0)
+	case flagset.FloatType:
+		return float64(0)
+	default:
+		return map[string]any{}
+	}
+}
@@ -0,0 +1,111 @@
+package usage
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+func TestGoEvaluations(t *testing.T) {
+	source := `package main
+
+func main() {
+	a, _ := client.BooleanValue(ctx, "a", true, evalCtx)
+	b, _ := client.IntValueDetails(ctx, "b", -3, evalCtx)
+	c, _ := client.FloatValue(r.Context(), "c", 2, evalCtx)
+	d, _ := client.StringValue(ctx, "d", defaultTheme, evalCtx)
+	e, _ := client.ObjectValue(ctx, "e", map[string]any{}, evalCtx)
+	f, _ := client.StringValue(ctx, key, "ignored", evalCtx)
+	g, _ := openfeature.NewDefaultClient().BooleanValue(context.Background(), "g", false, openfeature.EvaluationContext{})
+	h := cmd.Flags().String(name, "", "usage")
+	i := cmd.Flags().String("output", ".", "usage")
+	j := cmd.Flags().StringP("default", "d", "default", "usage")
+	k, _ := client.StringValue(ctx, "", "empty", evalCtx)
+}
+`
+	evaluations, err := goEvaluations("main.go", []byte(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Evaluation{
+		{Key: "a", Type: flagset.BoolType, DefaultValue: true, Location: Location{File: "main.go", Line: 4, Kind: KindSDK}},
+		{Key: "b", Type: flagset.IntType, DefaultValue: int64(-3), Location: Location{File: "main.go", Line: 5, Kind: KindSDK}},
+		{Key: "c", Type: flagset.FloatType, DefaultValue: float64(2), Location: Location{File: "main.go", Line: 6, Kind: KindSDK}},
+		{Key: "d", Type: flagset.StringType, Location: Location{File: "main.go", Line: 7, Kind: KindSDK}},
+		{Key: "e", Type: flagset.ObjectType, Location: Location{File: "main.go", Line: 8, Kind: KindSDK}},
+		{Key: "g", Type: flagset.BoolType, DefaultValue: false, Location: Location{File: "main.go", Line: 10, Kind: KindSDK}},
+	}
+	if diff := cmp.Diff(expected, evaluations); diff != "" {
+		t.Errorf("unexpected evaluations (-want +got):\n%s", diff)
+	}
+}
+
+func TestPatternEvaluations(t *testing.T) {
+	tests := []struct {
+		line         string
+		key          string
+		flagType     flagset.FlagType
+		defaultValue any
+	}{
+		{`client.getStringValue('theme', 'x')`, "theme", flagset.StringType, "x"},
+		{`client.getBooleanValue("enabled", true)`, "enabled", flagset.BoolType, true},
+		{`client.getNumberValue("limit", 25)`, "limit", flagset.IntType, int64(25)},
+		{`client.getNumberValue("ratio", 0.5)`, "ratio", flagset.FloatType, 0.5},
+		{`client.getObjectValue("layout", {})`, "layout", flagset.ObjectType, map[string]any{}},
+		{`client.getIntegerValue("retries", 3L)`, "retries", flagset.IntType, int64(3)},
+		{`client.getDoubleValue("ratio", 1.5d)`, "ratio", flagset.FloatType, 1.5},
+		{`client.get_boolean_value("enabled", False)`, "enabled", flagset.BoolType, false},
+		{`client.get_float_value("ratio", 2)`, "ratio", flagset.FloatType, float64(2)},
+		{`await client.GetStringValueAsync("theme", "dark")`, "theme", flagset.StringType, "dark"},
+		{`client.getStringValue("theme", defaultTheme)`, "theme", flagset.StringType, nil},
+		{`client.getBooleanValue("enabled", "true")`, "enabled", flagset.BoolType, nil},
+	}
+	for _, line := range []string{`client.getStringValue("", "x")`, `client.getStringValue(".", "x")`, `client.getBooleanValue("a b", true)`} {
+		if evaluations := patternEvaluations("file", line); len(evaluations) != 0 {
+			t.Errorf("expected no evaluations with an invalid flag key in %q, got %v", line, evaluations)
+		}
+	}
+	for _, tt := range tests {
+		evaluations := patternEvaluations("file", tt.line)
+		if len(evaluations) != 1 {
+			t.Errorf("expected one evaluation in %q, got %v", tt.line, evaluations)
+			continue
+		}
+		evaluation := evaluations[0]
+		if evaluation.Key != tt.key || evaluation.Type != tt.flagType || !cmp.Equal(evaluation.DefaultValue, tt.defaultValue) {
+			t.Errorf("expected %q to be evaluated as %s of type %v with the default %v, got %v",
+				tt.line, tt.key, tt.flagType, tt.defaultValue, evaluation)
+		}
+	}
+}
+
+func TestDraft(t *testing.T) {
+	location := func(line int) Location {
+		return Location{File: "app.ts", Line: line, Kind: KindSDK}
+	}
+	evaluations := []Evaluation{
+		{Key: "theme", Type: flagset.StringType, Location: location(1)},
+		{Key: "enabled", Type: flagset.BoolType, DefaultValue: false, Location: location(2)},
+		{Key: "theme", Type: flagset.StringType, DefaultValue: "dark", Location: location(3)},
+		{Key: "enabled", Type: flagset.StringType, DefaultValue: "no", Location: location(4)},
+		{Key: "theme", Type: flagset.StringType, DefaultValue: "light", Location: location(5)},
+		{Key: "limit", Type: flagset.IntType, Location: location(6)},
+	}
+
+	expected := []DraftFlag{
+		{Key: "theme", Type: flagset.StringType, DefaultValue: "dark", Conflicts: []string{
+			"default value dark at app.ts:3, but light at app.ts:5",
+		}},
+		{Key: "enabled", Type: flagset.BoolType, DefaultValue: false, Conflicts: []string{
+			"evaluated as boolean at app.ts:2, but as string at app.ts:4",
+		}},
+		{Key: "limit", Type: flagset.IntType, DefaultValue: int64(0), Conflicts: []string{
+			"no literal default value found, using 0",
+		}},
+	}
+	if diff := cmp.Diff(expected, Draft(evaluations)); diff != "" {
+		t.Errorf("unexpected draft (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,245 @@
+// Package usage finds the references to flags in source code.
+package usage
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/spf13/afero"
+)
+
+// GeneratedMarker is the header of the files the generators generate. Files containing it are not scanned,
+// since they declare the accessors rather than use them.
+const GeneratedMarker = "AUTOMATICALLY GENERATED BY OPENFEATURE CLI"
+
+// Kind is the kind of a reference to a flag.
+type Kind string
+
+const (
+	// KindAccessor is a reference to a generated accessor.
+	KindAccessor Kind = "accessor"
+	// KindSDK is an evaluation of the OpenFeature SDK with a string literal flag key.
+	KindSDK Kind = "sdk"
+)
+
+// SourceExtensions are the extensions of the files that are scanned.
+var SourceExtensions = []string{
+	".go", ".js", ".jsx", ".mjs", ".cjs", ".ts", ".tsx", ".vue", ".svelte",
+	".java", ".kt", ".py", ".cs", ".php", ".rb", ".dart",
+}
+
+// skippedDirs are directories that never contain code of the project.
+var skippedDirs = map[string]bool{
+	"node_modules": true,
+	"vendor":       true,
+}
+
+// sdkCallPattern matches evaluations of the OpenFeature SDKs whose flag key is a string literal, e.g.
+// client.BooleanValue(ctx, "key", false, evalCtx), client.getStringValue('key', 'default'),
+// client.get_integer_value("key", 1), useFlag("key", false) or fetch_boolean_value(flag_key: "key", ...).
+// The first group is the flag key.
+var sdkCallPattern = regexp.MustCompile(
+	`(?i)\b(?:(?:get_?|fetch_?|use)?(?:boolean|bool|string|number|integer|int|float|double|object|structure)_?(?:flag_?)?(?:value|details)(?:_?details)?(?:_?async)?|useFlag)\s*\(\s*` +
+		// an optional leading argument that isn't a string, e.g. the context of the Go SDK
+		`(?:[\w.]+(?:\(\))?\s*,\s*)?` +
+		`(?:flag_key:\s*)?` +
+		"[\"'`]([^\"'`]+)[\"'`]",
+)
+
+// Location is a reference to a flag in a file.
+type Location struct {
+	// File is the path of the file, relative to the scanned directory
+	File string `json:"file" yaml:"file"`
+	Line int    `json:"line" yaml:"line"`
+	Kind Kind   `json:"kind" yaml:"kind"`
+}
+
+// String returns the location as file:line.
+func (l Location) String() string {
+	return fmt.Sprintf("%s:%d", l.File, l.Line)
+}
+
+// FlagUsage are the references to a flag.
+type FlagUsage struct {
+	Key       string     `json:"key" yaml:"key"`
+	Locations []Location `json:"locations" yaml:"locations"`
+}
+
+// Report is the result of a scan.
+type Report struct {
+	// Flags are the references to the flags of the manifest, sorted by key
+	Flags []FlagUsage `json:"flags" yaml:"flags"`
+	// Unused are the keys of the flags of the manifest without references, sorted by key
+	Unused []string `json:"unused" yaml:"unused"`
+	// Undeclared are the references to flag keys that aren't defined in the manifest, sorted by key
+	Undeclared []FlagUsage `json:"undeclared" yaml:"undeclared"`
+}
+
+// Scanner finds the references to the flags of a flagset.
+type Scanner struct {
+	flagset *flagset.Flagset
+	// accessors are the flag keys of each accessor name
+	accessors       map[string][]string
+	accessorPattern *regexp.Regexp
+	// Exclude are glob patterns of paths, relative to the scanned directory, that are not scanned.
+	// A pattern without a slash is matched against the names of files and directories.
+	Exclude []string
+}
+
+// NewScanner creates a scanner for the flags of the flagset.
+// The accessors are the names of the generated accessors keyed by generator and flag key,
+// see generators.GeneratorManager.GetAccessors.
+func NewScanner(fs *flagset.Flagset, accessors map[string]map[string]string) *Scanner {
+	s := &Scanner{flagset: fs, accessors: map[string][]string{}}
+	for _, generatorAccessors := range accessors {
+		for key, name := range generatorAccessors {
+			if !containsString(s.accessors[name], key) {
+				s.accessors[name] = append(s.accessors[name], key)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(s.accessors))
+	for name := range s.accessors {
+		names = append(names, regexp.QuoteMeta(name))
+	}
+	if len(names) > 0 {
+		// Longer names first, so that the longest accessor at a position matches
+		sort.Slice(names, func(i, j int) bool {
+			if len(names[i]) != len(names[j]) {
+				return len(names[i]) > len(names[j])
+			}
+			return names[i] < names[j]
+		})
+		s.accessorPattern = regexp.MustCompile(`\b(` + strings.Join(names, "|") + `)\b`)
+	}
+	return s
+}
+
+// Scan walks the directory and returns the references to flags in its source files.
+// Hidden directories, dependencies and generated files are skipped.
+func (s *Scanner) Scan(dir string) (*Report, error) {
+	references := map[string][]Location{}
+	err := Walk(dir, s.Exclude, SourceExtensions, func(file string, data []byte) error {
+		s.scanFile(file, data, references)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Flags: []FlagUsage{}, Unused: []string{}, Undeclared: []FlagUsage{}}
+	declared := map[string]bool{}
+	for _, flag := range s.flagset.Flags {
+		declared[flag.Key] = true
+		if locations, ok := references[flag.Key]; ok {
+			report.Flags = append(report.Flags, FlagUsage{Key: flag.Key, Locations: locations})
+		} else {
+			report.Unused = append(report.Unused, flag.Key)
+		}
+	}
+	for key, locations := range references {
+		if !declared[key] {
+			report.Undeclared = append(report.Undeclared, FlagUsage{Key: key, Locations: locations})
+		}
+	}
+	sort.Slice(report.Undeclared, func(i, j int) bool {
+		return report.Undeclared[i].Key < report.Undeclared[j].Key
+	})
+	return report, nil
+}
+
+// scanFile adds the references in the file to the references, at most one per flag and line
+func (s *Scanner) scanFile(file string, data []byte, references map[string][]Location) {
+	add := func(key string, line int, kind Kind) {
+		locations := references[key]
+		if n := len(locations); n > 0 && locations[n-1].File == file && locations[n-1].Line == line {
+			return
+		}
+		references[key] = append(locations, Location{File: file, Line: line, Kind: kind})
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		for _, match := range sdkCallPattern.FindAllStringSubmatch(text, -1) {
+			add(match[1], line, KindSDK)
+		}
+		if s.accessorPattern == nil {
+			continue
+		}
+		for _, match := range s.accessorPattern.FindAllStringSubmatch(text, -1) {
+			for _, key := range s.accessors[match[1]] {
+				add(key, line, KindAccessor)
+			}
+		}
+	}
+}
+
+// Walk calls visit with the path relative to dir and the contents of each file in the directory
+// with one of the extensions, skipping hidden directories, dependencies, generated files and paths
+// matching the exclude patterns. A pattern without a slash is matched against the names of files and directories.
+func Walk(dir string, exclude, extensions []string, visit func(file string, data []byte) error) error {
+	fs := filesystem.FileSystem()
+	return afero.Walk(fs, dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if info.IsDir() {
+			if rel != "." && (strings.HasPrefix(info.Name(), ".") || skippedDirs[info.Name()] || excluded(exclude, rel)) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !containsString(extensions, path.Ext(rel)) || excluded(exclude, rel) {
+			return nil
+		}
+
+		data, err := afero.ReadFile(fs, p)
+		if err != nil {
+			return err
+		}
+		if bytes.Contains(data, []byte(GeneratedMarker)) {
+			return nil
+		}
+		return visit(rel, data)
+	})
+}
+
+// excluded reports whether the path matches one of the exclude patterns
+func excluded(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		target := rel
+		if !strings.Contains(pattern, "/") {
+			target = path.Base(rel)
+		}
+		if matched, _ := path.Match(pattern, target); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
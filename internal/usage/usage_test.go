@@ -0,0 +1,97 @@
+package usage
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/spf13/afero"
+)
+
+func TestScan(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	files := map[string]string{
+		"src/main.go": `package main
+
+func main() {
+	v, _ := flags.EnableFeatureA.Value(ctx, evalCtx)
+	n, _ := client.IntValue(ctx, "maxItems", 10, evalCtx)
+	s, _ := client.StringValueDetails(r.Context(), "legacyKey", "x", evalCtx)
+}
+`,
+		"src/app.tsx": `const a = useEnableFeatureA();
+const b = client.getBooleanValue('enableFeatureA', false);
+`,
+		"src/app.py":                   `w = flags.max_items()` + "\n",
+		"src/app_test.py":              `w = flags.unused_flag()` + "\n",
+		"src/README.md":                `useUnusedFlag()` + "\n",
+		"src/node_modules/lib/a.js":    `useUnusedFlag()` + "\n",
+		"src/.cache/a.js":              `useUnusedFlag()` + "\n",
+		"src/generated/openfeature.go": "// AUTOMATICALLY GENERATED BY OPENFEATURE CLI, DO NOT EDIT.\nvar UnusedFlag = 1\n",
+	}
+	for path, content := range files {
+		if err := afero.WriteFile(fs, path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	flags := &flagset.Flagset{Flags: []flagset.Flag{
+		{Key: "enableFeatureA", Type: flagset.BoolType},
+		{Key: "maxItems", Type: flagset.IntType},
+		{Key: "unusedFlag", Type: flagset.BoolType},
+	}}
+	accessors := map[string]map[string]string{
+		"go":     {"enableFeatureA": "EnableFeatureA", "maxItems": "MaxItems", "unusedFlag": "UnusedFlag"},
+		"react":  {"enableFeatureA": "useEnableFeatureA", "maxItems": "useMaxItems", "unusedFlag": "useUnusedFlag"},
+		"python": {"enableFeatureA": "enable_feature_a", "maxItems": "max_items", "unusedFlag": "unused_flag"},
+	}
+
+	scanner := NewScanner(flags, accessors)
+	scanner.Exclude = []string{"*_test.py"}
+	report, err := scanner.Scan("src")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &Report{
+		Flags: []FlagUsage{
+			{Key: "enableFeatureA", Locations: []Location{
+				{File: "app.tsx", Line: 1, Kind: KindAccessor},
+				{File: "app.tsx", Line: 2, Kind: KindSDK},
+				{File: "main.go", Line: 4, Kind: KindAccessor},
+			}},
+			{Key: "maxItems", Locations: []Location{
+				{File: "app.py", Line: 1, Kind: KindAccessor},
+				{File: "main.go", Line: 5, Kind: KindSDK},
+			}},
+		},
+		Unused: []string{"unusedFlag"},
+		Undeclared: []FlagUsage{
+			{Key: "legacyKey", Locations: []Location{{File: "main.go", Line: 6, Kind: KindSDK}}},
+		},
+	}
+	if diff := cmp.Diff(expected, report); diff != "" {
+		t.Errorf("unexpected report (-want +got):\n%s", diff)
+	}
+}
+
+func TestSDKCallPattern(t *testing.T) {
+	tests := map[string]string{
+		`client.BooleanValue(ctx, "go-key", false, evalCtx)`:                     "go-key",
+		`client.getStringValue('js-key', 'default')`:                             "js-key",
+		"client.getNumberDetails(`template-key`, 1)":                             "template-key",
+		`client.get_integer_value("python-key", 1)`:                              "python-key",
+		`await client.GetBooleanValueAsync("csharp-key", false)`:                 "csharp-key",
+		`client.fetch_boolean_value(flag_key: "ruby-key", default_value: false)`: "ruby-key",
+		`const { value } = useBooleanFlagValue("react-key", false)`:              "react-key",
+		`useFlag("hook-key", false)`:                                             "hook-key",
+	}
+	for line, key := range tests {
+		match := sdkCallPattern.FindStringSubmatch(line)
+		if match == nil || match[1] != key {
+			t.Errorf("expected %q to match the key %q, got %v", line, key, match)
+		}
+	}
+}
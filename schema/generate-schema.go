@@ -9,16 +9,23 @@ import (
 	"github.com/open-feature/cli/internal/manifest"
 )
 
-const schemaPath = "schema/v0/flag-manifest.json"
-
 func main() {
-	schema := manifest.ToJSONSchema()
+	for _, version := range []string{manifest.VersionV0, manifest.VersionV1} {
+		generate(version)
+	}
+}
+
+func generate(version string) {
+	schemaDir := "schema/" + version
+	schemaPath := schemaDir + "/flag-manifest.json"
+
+	schema := manifest.ToJSONSchema(version)
 	data, err := json.MarshalIndent(schema, "", "  ")
 	if err != nil {
 		log.Fatal(fmt.Errorf("failed to marshal JSON schema: %w", err))
 	}
 
-	if err := os.MkdirAll("schema/v0", os.ModePerm); err != nil {
+	if err := os.MkdirAll(schemaDir, os.ModePerm); err != nil {
 		log.Fatal(fmt.Errorf("failed to create directory: %w", err))
 	}
 
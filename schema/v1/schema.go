@@ -0,0 +1,9 @@
+// Package schema embeds the v1 flag manifest schema into a code module.
+package schema
+
+import _ "embed"
+
+// Schema contains the embedded flag manifest schema.
+//
+//go:embed flag-manifest.json
+var SchemaFile string
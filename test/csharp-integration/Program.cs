@@ -1,6 +1,7 @@
 using System;
 using System.Threading.Tasks;
 using Microsoft.Extensions.DependencyInjection;
+using Microsoft.Extensions.Options;
 using OpenFeature;
 using OpenFeature.Model;
 using TestNamespace;
@@ -26,6 +27,15 @@ namespace CompileTest
             // Test client retrieval from DI
             var client = serviceProvider.GetRequiredService<GeneratedClient>();
             
+            // Test domain-scoped clients registered as keyed services
+            var keyedServices = new ServiceCollection()
+                .AddLogging()
+                .AddOpenFeatureLoggingHook()
+                .AddOpenFeature(new[] { "tenant-a", "tenant-b" });
+            var keyedServiceProvider = keyedServices.BuildServiceProvider().UseOpenFeatureLoggingHook();
+            var tenantClient = keyedServiceProvider.GetRequiredKeyedService<GeneratedClient>("tenant-a");
+            var defaults = keyedServiceProvider.GetRequiredService<IOptions<GeneratedFlagDefaults>>().Value;
+
             // Also test the traditional factory method
             var clientFromFactory = GeneratedClient.CreateClient();
             
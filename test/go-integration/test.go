@@ -84,6 +84,27 @@ func run() error {
 	}
 	fmt.Printf("usernameMaxLength: %v\n", usernameMaxLength)
 
+	// Use the generated client bound to a domain with its own provider
+	domainProvider := memprovider.NewInMemoryProvider(map[string]memprovider.InMemoryFlag{
+		"enableFeatureA": {
+			State:          memprovider.Enabled,
+			DefaultVariant: "on",
+			Variants: map[string]any{
+				"on": true,
+			},
+		},
+	})
+	err = openfeature.SetNamedProviderAndWait("test-domain", domainProvider)
+	if err != nil {
+		return fmt.Errorf("Failed to set domain provider: %w", err)
+	}
+
+	domainEnableFeatureA, err := generated.NewDomainClient("test-domain").EnableFeatureA(ctx, evalCtx)
+	if err != nil {
+		return fmt.Errorf("Error evaluating boolean flag with domain client: %w", err)
+	}
+	fmt.Printf("enableFeatureA (test-domain): %v\n", domainEnableFeatureA)
+
 	fmt.Println("Generated Go code compiles successfully!")
 
 	return nil
@@ -47,6 +47,7 @@ func (t *Test) Run(ctx context.Context, client *dagger.Client) (*dagger.Containe
 		"--manifest=/src/sample/sample_manifest.json",
 		"--output=/tmp/generated",
 		"--namespace=TestNamespace",
+		"--logging-hook",
 	})
 
 	// Get generated files